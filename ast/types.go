@@ -22,29 +22,98 @@ type Value interface {
 	value()
 	IsZero() bool
 	GetArgumentNames() (names []string)
+	GetVariableNames() (names []string)
+	GetTermNames() (names []string)
+	GetMessageNames() (names []string)
 }
 
+func getVariableNames(formatParts []FormatParts) (vars []string) {
+	for _, parts := range formatParts {
+		names := parts.GetVariableNames()
+		for _, name := range names {
+			if !slices.Contains(vars, name) {
+				vars = append(vars, name)
+			}
+		}
+	}
+	return vars
+}
+
+func getTermNames(formatParts []FormatParts) (terms []string) {
+	for _, parts := range formatParts {
+		names := parts.GetTermNames()
+		for _, name := range names {
+			if !slices.Contains(terms, name) {
+				terms = append(terms, name)
+			}
+		}
+	}
+	return terms
+}
+
+func getMessageNames(formatParts []FormatParts) (msgs []string) {
+	for _, parts := range formatParts {
+		names := parts.GetMessageNames()
+		for _, name := range names {
+			if !slices.Contains(msgs, name) {
+				msgs = append(msgs, name)
+			}
+		}
+	}
+	return msgs
+}
+
+// PluralType is a kind of CLDR plural rules used to select a plural form.
+type PluralType int
+
+const (
+	PluralCardinal PluralType = iota
+	PluralOrdinal
+)
+
+// PluralCase is a plural branch that matches an exact value.
+type PluralCase struct {
+	Value  int
+	Format FormatParts
+}
+
+// Plural contains branches for each of the CLDR plural categories
+// and branches for exact values, which take precedence over categories.
 type Plural struct {
-	Arg   string
-	Zero  FormatParts
-	One   FormatParts
-	Many  FormatParts
-	Other FormatParts
+	Type    PluralType
+	Arg     string
+	FmtInfo FmtInfo
+	Offset  int
+	Exact   []PluralCase
+	Zero    FormatParts
+	One     FormatParts
+	Two     FormatParts
+	Few     FormatParts
+	Many    FormatParts
+	Other   FormatParts
 }
 
 func (Plural) value() {}
 
+// Checks whether the plural argument is a floating point number.
+func (p *Plural) IsFloat() bool {
+	return p.FmtInfo.Spec == 'f'
+}
+
 func (p *Plural) IsZero() bool {
 	return p.Arg == "" &&
+		p.Exact == nil &&
 		p.Zero == nil &&
 		p.One == nil &&
+		p.Two == nil &&
+		p.Few == nil &&
 		p.Many == nil &&
 		p.Other == nil
 }
 
 func (p *Plural) GetArgumentNames() (args []string) {
 	args = append(args, p.Arg)
-	formatParts := []FormatParts{p.Zero, p.One, p.Many, p.Other}
+	formatParts := p.formatParts()
 
 	for _, parts := range formatParts {
 		names := parts.GetArgumentNames()
@@ -59,7 +128,210 @@ func (p *Plural) GetArgumentNames() (args []string) {
 }
 
 func (p *Plural) IsSimple() bool {
-	formatParts := []FormatParts{p.Zero, p.One, p.Many, p.Other}
+	formatParts := p.formatParts()
+
+	for _, parts := range formatParts {
+		if !parts.IsSimple() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *Plural) GetVariableNames() (vars []string) {
+	return getVariableNames(p.formatParts())
+}
+
+func (p *Plural) GetTermNames() (terms []string) {
+	return getTermNames(p.formatParts())
+}
+
+func (p *Plural) GetMessageNames() (msgs []string) {
+	return getMessageNames(p.formatParts())
+}
+
+func (p *Plural) formatParts() (formatParts []FormatParts) {
+	for i := 0; i < len(p.Exact); i++ {
+		formatParts = append(formatParts, p.Exact[i].Format)
+	}
+	return append(formatParts, p.Zero, p.One, p.Two, p.Few, p.Many, p.Other)
+}
+
+// SelectCase is a select branch that matches a key.
+type SelectCase struct {
+	Key    string
+	Format FormatParts
+}
+
+// Select contains branches for arbitrary keys
+// and the "other" branch for any other value.
+// If Type is specified, keys are constants of this type.
+// If Term is specified, the branch is selected by the gender of the term.
+type Select struct {
+	Arg   string
+	Type  GoType
+	Term  string
+	Cases []SelectCase
+	Other FormatParts
+}
+
+func (Select) value() {}
+
+func (s *Select) IsZero() bool {
+	return s.Arg == "" &&
+		s.Type.IsZero() &&
+		s.Term == "" &&
+		s.Cases == nil &&
+		s.Other == nil
+}
+
+func (s *Select) GetArgumentNames() (args []string) {
+	if s.Arg != "" {
+		args = append(args, s.Arg)
+	}
+	formatParts := s.formatParts()
+
+	for _, parts := range formatParts {
+		names := parts.GetArgumentNames()
+		for _, name := range names {
+			if !slices.Contains(args, name) {
+				args = append(args, name)
+			}
+		}
+	}
+
+	return args
+}
+
+func (s *Select) IsSimple() bool {
+	formatParts := s.formatParts()
+
+	for _, parts := range formatParts {
+		if !parts.IsSimple() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Select) GetVariableNames() (vars []string) {
+	return getVariableNames(s.formatParts())
+}
+
+func (s *Select) GetTermNames() (terms []string) {
+	return getTermNames(s.formatParts())
+}
+
+func (s *Select) GetMessageNames() (msgs []string) {
+	return getMessageNames(s.formatParts())
+}
+
+func (s *Select) formatParts() (formatParts []FormatParts) {
+	for i := 0; i < len(s.Cases); i++ {
+		formatParts = append(formatParts, s.Cases[i].Format)
+	}
+	return append(formatParts, s.Other)
+}
+
+// When contains branches for true and false
+// values of a boolean argument.
+type When struct {
+	Arg   string
+	True  FormatParts
+	False FormatParts
+}
+
+func (When) value() {}
+
+func (w *When) IsZero() bool {
+	return w.Arg == "" &&
+		w.True == nil &&
+		w.False == nil
+}
+
+func (w *When) GetArgumentNames() (args []string) {
+	args = append(args, w.Arg)
+	formatParts := []FormatParts{w.True, w.False}
+
+	for _, parts := range formatParts {
+		names := parts.GetArgumentNames()
+		for _, name := range names {
+			if !slices.Contains(args, name) {
+				args = append(args, name)
+			}
+		}
+	}
+
+	return args
+}
+
+func (w *When) GetVariableNames() (vars []string) {
+	return getVariableNames([]FormatParts{w.True, w.False})
+}
+
+func (w *When) GetTermNames() (terms []string) {
+	return getTermNames([]FormatParts{w.True, w.False})
+}
+
+func (w *When) GetMessageNames() (msgs []string) {
+	return getMessageNames([]FormatParts{w.True, w.False})
+}
+
+func (w *When) IsSimple() bool {
+	return w.True.IsSimple() && w.False.IsSimple()
+}
+
+type BoundOpt struct {
+	Value int
+	Valid bool
+}
+
+// RangeCase is a range branch that matches values
+// between Min and Max inclusively.
+// Invalid bound means that the range is unbounded from that side.
+type RangeCase struct {
+	Key    string
+	Min    BoundOpt
+	Max    BoundOpt
+	Format FormatParts
+}
+
+// Range contains branches for ranges of an integral argument
+// and the "other" branch for values not in any of the ranges.
+type Range struct {
+	Arg   string
+	Cases []RangeCase
+	Other FormatParts
+}
+
+func (Range) value() {}
+
+func (r *Range) IsZero() bool {
+	return r.Arg == "" &&
+		r.Cases == nil &&
+		r.Other == nil
+}
+
+func (r *Range) GetArgumentNames() (args []string) {
+	args = append(args, r.Arg)
+	formatParts := r.formatParts()
+
+	for _, parts := range formatParts {
+		names := parts.GetArgumentNames()
+		for _, name := range names {
+			if !slices.Contains(args, name) {
+				args = append(args, name)
+			}
+		}
+	}
+
+	return args
+}
+
+func (r *Range) IsSimple() bool {
+	formatParts := r.formatParts()
 
 	for _, parts := range formatParts {
 		if !parts.IsSimple() {
@@ -70,19 +342,67 @@ func (p *Plural) IsSimple() bool {
 	return true
 }
 
+func (r *Range) GetVariableNames() (vars []string) {
+	return getVariableNames(r.formatParts())
+}
+
+func (r *Range) GetTermNames() (terms []string) {
+	return getTermNames(r.formatParts())
+}
+
+func (r *Range) GetMessageNames() (msgs []string) {
+	return getMessageNames(r.formatParts())
+}
+
+func (r *Range) formatParts() (formatParts []FormatParts) {
+	for i := 0; i < len(r.Cases); i++ {
+		formatParts = append(formatParts, r.Cases[i].Format)
+	}
+	return append(formatParts, r.Other)
+}
+
 type Variable struct {
-	Name   string
-	Plural Plural
-	String FormatParts
+	Name    string
+	Plural  Plural
+	Ordinal Plural
+	Select  Select
+	When    When
+	Range   Range
+	String  FormatParts
+}
+
+// Term is a variable defined at the file level,
+// which can be referenced from any message of the file.
+// Term can also have named grammatical forms and gender.
+type Term struct {
+	Variable
+	Forms  []Variable
+	Gender string
 }
 
 type Message struct {
 	Name      string
+	Namespace string
 	Variables []Variable
 	Plural    Plural
+	Ordinal   Plural
+	Select    Select
+	When      When
+	Range     Range
 	String    FormatParts
 }
 
+// Separates nested namespaces and names of the messages within them.
+const NamespaceSeparator = "."
+
+// Returns the name qualified with the namespace.
+func JoinNamespace(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + NamespaceSeparator + name
+}
+
 type GoImport struct {
 	Import  string
 	Package string
@@ -129,6 +449,8 @@ func (i *FmtInfo) GoFormat(goType GoType) string {
 			spec = 'd'
 		case "float64":
 			spec = 'f'
+		case "bool":
+			spec = 't'
 		default:
 			spec = 'v'
 		}
@@ -167,15 +489,35 @@ type ArgInfo struct {
 	FmtInfo FmtInfo
 }
 
+// VarInfo is a reference to a variable of the message
+// or, if Term is set, to a term of the file or its form.
 type VarInfo struct {
 	Name string
+	Term bool
+	Form string
+}
+
+// MsgInfo is a reference to another message of the same localization.
+type MsgInfo struct {
+	Name string
+}
+
+// NumberInfo is a placeholder for the plural argument
+// with the plural offset subtracted from it.
+type NumberInfo struct {
+	Arg     string
+	Offset  int
+	Float   bool
+	FmtInfo FmtInfo
 }
 
 type Text string
 
-func (ArgInfo) formatPart() {}
-func (VarInfo) formatPart() {}
-func (Text) formatPart()    {}
+func (ArgInfo) formatPart()    {}
+func (VarInfo) formatPart()    {}
+func (MsgInfo) formatPart()    {}
+func (NumberInfo) formatPart() {}
+func (Text) formatPart()       {}
 
 type FormatParts []FormatPart
 
@@ -187,14 +529,65 @@ func (f FormatParts) IsZero() bool {
 
 func (f FormatParts) GetArgumentNames() (args []string) {
 	for _, part := range f {
-		arg, ok := part.(ArgInfo)
-		if ok && !slices.Contains(args, arg.Name) {
-			args = append(args, arg.Name)
+		var name string
+
+		switch part := part.(type) {
+		case ArgInfo:
+			name = part.Name
+		case NumberInfo:
+			name = part.Arg
+		default:
+			continue
+		}
+
+		if name != "" && !slices.Contains(args, name) {
+			args = append(args, name)
 		}
 	}
 	return args
 }
 
+func (f FormatParts) GetVariableNames() (vars []string) {
+	for _, part := range f {
+		variable, ok := part.(VarInfo)
+		if ok && !variable.Term && !slices.Contains(vars, variable.Name) {
+			vars = append(vars, variable.Name)
+		}
+	}
+	return vars
+}
+
+// Returns names of the referenced terms.
+// If the term form is referenced, the name is "term:form".
+func (f FormatParts) GetTermNames() (terms []string) {
+	for _, part := range f {
+		variable, ok := part.(VarInfo)
+		if !ok || !variable.Term {
+			continue
+		}
+
+		name := variable.Name
+		if variable.Form != "" {
+			name += ":" + variable.Form
+		}
+
+		if !slices.Contains(terms, name) {
+			terms = append(terms, name)
+		}
+	}
+	return terms
+}
+
+func (f FormatParts) GetMessageNames() (msgs []string) {
+	for _, part := range f {
+		msg, ok := part.(MsgInfo)
+		if ok && !slices.Contains(msgs, msg.Name) {
+			msgs = append(msgs, msg.Name)
+		}
+	}
+	return msgs
+}
+
 func (f FormatParts) IsSimple() bool {
 	for _, part := range f {
 		if _, ok := part.(Text); !ok {
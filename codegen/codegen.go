@@ -3,24 +3,95 @@ package codegen
 import (
 	goast "go/ast"
 	gotoken "go/token"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/infastin/l10n-go/ast"
 	"github.com/infastin/l10n-go/common"
 	"github.com/infastin/l10n-go/scope"
 )
 
-func GenerateLocalizations(locs []scope.Localization) (files []*goast.File) {
-	files = append(files, generateGeneral(locs))
+// Generated file along with its name without the extension.
+// Files not depending on localizations have Source instead of Ast.
+type File struct {
+	Name   string
+	Ast    *goast.File
+	Source string
+}
+
+func GenerateLocalizations(locs []scope.Localization) (files []File) {
+	files = append(files, File{Name: "l10n", Ast: generateGeneral(locs)})
+
+	if common.Config.Env {
+		files = append(files, File{Name: "l10n_env", Source: generateStatic(envSource)})
+	}
+
+	if common.Config.Lang {
+		files = append(files, File{Name: "l10n_lang", Source: generateStatic(langSource)})
+	}
+
+	if common.Config.Localize {
+		files = append(files, File{Name: "l10n_localize", Ast: generateLocalize(locs)})
+	}
+
+	if common.Config.HTTP {
+		files = append(files, File{Name: "l10n_http", Source: generateStatic(httpSource)})
+	}
 
 	for i := 0; i < len(locs); i++ {
-		files = append(files, generateMessages(&locs[i]))
+		loc := &locs[i]
+
+		if !common.Config.FileNamespaces {
+			files = append(files, File{
+				Name: loc.Name + "_" + loc.Lang.String(),
+				Ast:  generateMessages(loc, loc.Scopes, true),
+			})
+			continue
+		}
+
+		// Each top-level namespace corresponds to a localization file,
+		// so its messages are generated in a separate file
+		for _, namespace := range getChildNamespaces(getNamespaces(loc.Scopes), "") {
+			var mss []scope.MessageScope
+
+			for j := 0; j < len(loc.Scopes); j++ {
+				if getTopNamespace(loc.Scopes[j].Namespace) == namespace {
+					mss = append(mss, loc.Scopes[j])
+				}
+			}
+
+			files = append(files, File{
+				Name: getNamespaceFileName(namespace) + "_" + loc.Lang.String(),
+				Ast:  generateMessages(loc, mss, false),
+			})
+		}
+
+		// Plural functions are collected while generating messages,
+		// so the localizer itself is generated last
+		var mss []scope.MessageScope
+
+		for j := 0; j < len(loc.Scopes); j++ {
+			if loc.Scopes[j].Namespace == "" {
+				mss = append(mss, loc.Scopes[j])
+			}
+		}
+
+		files = append(files, File{
+			Name: "l10n_" + loc.Lang.String(),
+			Ast:  generateMessages(loc, mss, true),
+		})
 	}
 
 	return files
 }
 
+// Prepends the header and the package clause to the source.
+func generateStatic(source string) string {
+	return "// Code generated by l10n-go; DO NOT EDIT.\n\npackage " + common.Config.PackageName + "\n\n" + source
+}
+
 func generateGeneral(locs []scope.Localization) (file *goast.File) {
 	file = &goast.File{
 		Doc: &goast.CommentGroup{
@@ -33,12 +104,26 @@ func generateGeneral(locs []scope.Localization) (file *goast.File) {
 		Decls: []goast.Decl{},
 	}
 
-	if len(common.Config.Imports) != 0 {
+	imports := slices.Clone(common.Config.Imports)
+
+	// Language package is used for language negotiation
+	languageImport := ast.GoImport{Import: "golang.org/x/text/language", Package: "language"}
+	if !slices.Contains(imports, languageImport) {
+		imports = append(imports, languageImport)
+	}
+
+	for _, imp := range getArgumentImports(locs[0].Scopes) {
+		if !slices.Contains(imports, imp) {
+			imports = append(imports, imp)
+		}
+	}
+
+	if len(imports) != 0 {
 		importDecl := &goast.GenDecl{
 			Tok: gotoken.IMPORT,
 		}
 
-		for _, imp := range common.Config.Imports {
+		for _, imp := range imports {
 			importDecl.Specs = append(importDecl.Specs, &goast.ImportSpec{
 				Path: &goast.BasicLit{
 					Kind:  gotoken.STRING,
@@ -50,30 +135,48 @@ func generateGeneral(locs []scope.Localization) (file *goast.File) {
 		file.Decls = append(file.Decls, importDecl)
 	}
 
-	file.Decls = append(file.Decls, &goast.GenDecl{
-		Tok: gotoken.TYPE,
-		Specs: []goast.Spec{
-			&goast.TypeSpec{
-				Name: goast.NewIdent("Localizer"),
-				Type: generateGeneralInterface(locs[0].Scopes),
+	// Root namespace goes first
+	namespaces := append([]string{""}, getNamespaces(locs[0].Scopes)...)
+
+	for _, namespace := range namespaces {
+		file.Decls = append(file.Decls, &goast.GenDecl{
+			Tok: gotoken.TYPE,
+			Specs: []goast.Spec{
+				&goast.TypeSpec{
+					Name: goast.NewIdent(getNamespaceInterfaceName(namespace)),
+					Type: generateGeneralInterface(locs[0].Scopes, namespaces, namespace),
+				},
 			},
-		},
-	})
+		})
+	}
 
 	generateGeneralTable(locs, &file.Decls)
 	generateGeneralSupported(locs, &file.Decls)
+	generateGeneralSupportedTags(locs, &file.Decls)
+	generateGeneralMatcher(locs, &file.Decls)
 	generateGeneralFuncs(locs, &file.Decls)
 
 	return file
 }
 
-func generateGeneralInterface(msgs []scope.MessageScope) (ifaceType *goast.InterfaceType) {
+// Generates interface containing messages of the namespace
+// and accessors for its nested namespaces.
+// Root interface also contains the method returning the language tag.
+func generateGeneralInterface(
+	msgs []scope.MessageScope,
+	namespaces []string,
+	namespace string,
+) (ifaceType *goast.InterfaceType) {
 	ifaceType = &goast.InterfaceType{
 		Methods: &goast.FieldList{},
 	}
 
 	for i := 0; i < len(msgs); i++ {
 		msg := &msgs[i]
+		if msg.Namespace != namespace {
+			continue
+		}
+
 		funcType := &goast.FuncType{
 			Params: &goast.FieldList{},
 			Results: &goast.FieldList{
@@ -96,6 +199,39 @@ func generateGeneralInterface(msgs []scope.MessageScope) (ifaceType *goast.Inter
 		})
 	}
 
+	for _, child := range getChildNamespaces(namespaces, namespace) {
+		ifaceType.Methods.List = append(ifaceType.Methods.List, &goast.Field{
+			Names: []*goast.Ident{goast.NewIdent(getNamespaceAccessorName(child))},
+			Type: &goast.FuncType{
+				Params: &goast.FieldList{},
+				Results: &goast.FieldList{
+					List: []*goast.Field{
+						{Type: goast.NewIdent(getNamespaceInterfaceName(child))},
+					},
+				},
+			},
+		})
+	}
+
+	if namespace == "" {
+		ifaceType.Methods.List = append(ifaceType.Methods.List, &goast.Field{
+			Names: []*goast.Ident{goast.NewIdent("Tag")},
+			Type: &goast.FuncType{
+				Params: &goast.FieldList{},
+				Results: &goast.FieldList{
+					List: []*goast.Field{
+						{
+							Type: &goast.SelectorExpr{
+								X:   goast.NewIdent("language"),
+								Sel: goast.NewIdent("Tag"),
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
 	return ifaceType
 }
 
@@ -126,6 +262,31 @@ func generateGeneralSupported(locs []scope.Localization, decls *[]goast.Decl) {
 	*decls = append(*decls, varDecl)
 }
 
+func generateGeneralSupportedTags(locs []scope.Localization, decls *[]goast.Decl) {
+	sliceLit := &goast.CompositeLit{
+		Type: &goast.ArrayType{
+			Elt: &goast.SelectorExpr{
+				X:   goast.NewIdent("language"),
+				Sel: goast.NewIdent("Tag"),
+			},
+		},
+	}
+
+	for i := 0; i < len(locs); i++ {
+		sliceLit.Elts = append(sliceLit.Elts, goast.NewIdent(getLocalizerTagName(&locs[i])))
+	}
+
+	*decls = append(*decls, &goast.GenDecl{
+		Tok: gotoken.VAR,
+		Specs: []goast.Spec{
+			&goast.ValueSpec{
+				Names:  []*goast.Ident{goast.NewIdent("SupportedTags")},
+				Values: []goast.Expr{sliceLit},
+			},
+		},
+	})
+}
+
 func generateGeneralTable(locs []scope.Localization, decls *[]goast.Decl) {
 	mapLit := &goast.CompositeLit{
 		Type: &goast.MapType{
@@ -156,11 +317,241 @@ func generateGeneralTable(locs []scope.Localization, decls *[]goast.Decl) {
 	*decls = append(*decls, varDecl)
 }
 
+// Generates language matcher over the supported languages.
+// Base localization goes first, so it is returned when nothing matches.
+func generateGeneralMatcher(_ []scope.Localization, decls *[]goast.Decl) {
+	*decls = append(*decls, &goast.GenDecl{
+		Tok: gotoken.VAR,
+		Specs: []goast.Spec{
+			&goast.ValueSpec{
+				Names: []*goast.Ident{goast.NewIdent("matcher")},
+				Values: []goast.Expr{
+					&goast.CallExpr{
+						Fun: &goast.SelectorExpr{
+							X:   goast.NewIdent("language"),
+							Sel: goast.NewIdent("NewMatcher"),
+						},
+						Args: []goast.Expr{goast.NewIdent("SupportedTags")},
+					},
+				},
+			},
+		},
+	})
+}
+
 func generateGeneralFuncs(locs []scope.Localization, decls *[]goast.Decl) {
 	generateGeneralFuncNew(locs, decls)
+	generateGeneralFuncNewTag(locs, decls)
+	generateGeneralFuncMustNew(locs, decls)
+	generateGeneralFuncMatch(locs, decls)
+	generateGeneralFuncFromAcceptLanguage(locs, decls)
 	generateGeneralFuncLang(locs, decls)
 }
 
+func generateGeneralFuncNewTag(_ []scope.Localization, decls *[]goast.Decl) {
+	*decls = append(*decls, &goast.FuncDecl{
+		Name: goast.NewIdent("NewTag"),
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{goast.NewIdent("tag")},
+						Type: &goast.SelectorExpr{
+							X:   goast.NewIdent("language"),
+							Sel: goast.NewIdent("Tag"),
+						},
+					},
+				},
+			},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{goast.NewIdent("loc")},
+						Type:  goast.NewIdent("Localizer"),
+					},
+					{
+						Names: []*goast.Ident{goast.NewIdent("ok")},
+						Type:  goast.NewIdent("bool"),
+					},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				&goast.AssignStmt{
+					Lhs: []goast.Expr{goast.NewIdent("loc"), goast.NewIdent("ok")},
+					Tok: gotoken.ASSIGN,
+					Rhs: []goast.Expr{
+						&goast.IndexExpr{
+							X: goast.NewIdent("mapLangToLocalizer"),
+							Index: &goast.CallExpr{
+								Fun: &goast.SelectorExpr{
+									X:   goast.NewIdent("tag"),
+									Sel: goast.NewIdent("String"),
+								},
+							},
+						},
+					},
+				},
+				&goast.ReturnStmt{
+					Results: []goast.Expr{
+						goast.NewIdent("loc"),
+						goast.NewIdent("ok"),
+					},
+				},
+			},
+		},
+	})
+}
+
+func generateGeneralFuncMustNew(_ []scope.Localization, decls *[]goast.Decl) {
+	*decls = append(*decls, &goast.FuncDecl{
+		Name: goast.NewIdent("MustNew"),
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{goast.NewIdent("lang")},
+						Type:  goast.NewIdent("string"),
+					},
+				},
+			},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{Type: goast.NewIdent("Localizer")},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				&goast.AssignStmt{
+					Lhs: []goast.Expr{goast.NewIdent("tag"), goast.NewIdent("_")},
+					Tok: gotoken.DEFINE,
+					Rhs: []goast.Expr{
+						&goast.CallExpr{
+							Fun: &goast.SelectorExpr{
+								X:   goast.NewIdent("language"),
+								Sel: goast.NewIdent("Parse"),
+							},
+							Args: []goast.Expr{goast.NewIdent("lang")},
+						},
+					},
+				},
+				&goast.ReturnStmt{
+					Results: []goast.Expr{
+						&goast.CallExpr{
+							Fun:  goast.NewIdent("Match"),
+							Args: []goast.Expr{goast.NewIdent("tag")},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func generateGeneralFuncMatch(_ []scope.Localization, decls *[]goast.Decl) {
+	*decls = append(*decls, &goast.FuncDecl{
+		Name: goast.NewIdent("Match"),
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{goast.NewIdent("tags")},
+						Type: &goast.Ellipsis{
+							Elt: &goast.SelectorExpr{
+								X:   goast.NewIdent("language"),
+								Sel: goast.NewIdent("Tag"),
+							},
+						},
+					},
+				},
+			},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{Type: goast.NewIdent("Localizer")},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				&goast.AssignStmt{
+					Lhs: []goast.Expr{goast.NewIdent("_"), goast.NewIdent("idx"), goast.NewIdent("_")},
+					Tok: gotoken.DEFINE,
+					Rhs: []goast.Expr{
+						&goast.CallExpr{
+							Fun: &goast.SelectorExpr{
+								X:   goast.NewIdent("matcher"),
+								Sel: goast.NewIdent("Match"),
+							},
+							Args:     []goast.Expr{goast.NewIdent("tags")},
+							Ellipsis: 1,
+						},
+					},
+				},
+				&goast.ReturnStmt{
+					Results: []goast.Expr{
+						&goast.IndexExpr{
+							X: goast.NewIdent("mapLangToLocalizer"),
+							Index: &goast.IndexExpr{
+								X:     goast.NewIdent("Supported"),
+								Index: goast.NewIdent("idx"),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func generateGeneralFuncFromAcceptLanguage(_ []scope.Localization, decls *[]goast.Decl) {
+	*decls = append(*decls, &goast.FuncDecl{
+		Name: goast.NewIdent("FromAcceptLanguage"),
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{goast.NewIdent("header")},
+						Type:  goast.NewIdent("string"),
+					},
+				},
+			},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{Type: goast.NewIdent("Localizer")},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				&goast.AssignStmt{
+					Lhs: []goast.Expr{goast.NewIdent("tags"), goast.NewIdent("_"), goast.NewIdent("_")},
+					Tok: gotoken.DEFINE,
+					Rhs: []goast.Expr{
+						&goast.CallExpr{
+							Fun: &goast.SelectorExpr{
+								X:   goast.NewIdent("language"),
+								Sel: goast.NewIdent("ParseAcceptLanguage"),
+							},
+							Args: []goast.Expr{goast.NewIdent("header")},
+						},
+					},
+				},
+				&goast.ReturnStmt{
+					Results: []goast.Expr{
+						&goast.CallExpr{
+							Fun:      goast.NewIdent("Match"),
+							Args:     []goast.Expr{goast.NewIdent("tags")},
+							Ellipsis: 1,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
 func generateGeneralFuncNew(_ []scope.Localization, decls *[]goast.Decl) {
 	*decls = append(*decls, &goast.FuncDecl{
 		Name: goast.NewIdent("New"),
@@ -275,7 +666,10 @@ func generateGeneralFuncLang(locs []scope.Localization, decls *[]goast.Decl) {
 	*decls = append(*decls, funcDecl)
 }
 
-func generateMessages(loc *scope.Localization) (file *goast.File) {
+// Generates file with the given messages of the localization.
+// If root is true, the file also contains the localizer type,
+// its terms and plural functions.
+func generateMessages(loc *scope.Localization, mss []scope.MessageScope, root bool) (file *goast.File) {
 	file = &goast.File{
 		Name:  goast.NewIdent(common.Config.PackageName),
 		Decls: []goast.Decl{},
@@ -289,17 +683,53 @@ func generateMessages(loc *scope.Localization) (file *goast.File) {
 
 	var decls []goast.Decl
 
-	for i := 0; i < len(loc.Scopes); i++ {
-		ms := &loc.Scopes[i]
-		if ms.IsSimple() {
+	// Each file has its own imports
+	loc.Imports = nil
+
+	for _, imp := range getArgumentImports(mss) {
+		loc.AddImport(imp)
+	}
+
+	for i := 0; root && i < len(loc.Terms); i++ {
+		ts := &loc.Terms[i]
+
+		for _, imp := range getArgumentImports([]scope.MessageScope{ts.MessageScope}) {
+			loc.AddImport(imp)
+		}
+
+		if ts.HasValue() {
+			generateTermFunc(loc, ts, &ts.MessageScope, "", &decls)
+		}
+
+		for j := 0; j < len(ts.Forms); j++ {
+			generateTermFunc(loc, ts, &ts.Forms[j], ts.Forms[j].Name, &decls)
+		}
+	}
+
+	for i := 0; i < len(mss); i++ {
+		ms := &mss[i]
+		if ms.Delegate != nil {
+			generateDelegatedMessage(loc, ms, &decls)
+		} else if ms.IsSimple() {
 			generateSimpleMessage(loc, ms, &decls)
 		} else {
 			generateMessage(loc, ms, &decls)
 		}
 	}
 
+	namespaces := getNamespaces(mss)
+	if root {
+		// Root namespace goes first
+		namespaces = append([]string{""}, namespaces...)
+	}
+
+	generateMessagesNamespaceAccessors(loc, namespaces, &decls)
+	if root {
+		generateMessagesTagDecls(loc, &decls)
+		generateMessagesPluralDecls(loc, &decls)
+	}
 	generateMessagesImportDecl(loc, &file.Decls)
-	generateMessagesTypeDecl(loc, &file.Decls)
+	generateMessagesTypeDecl(loc, namespaces, &file.Decls)
 
 	file.Decls = append(file.Decls, decls...)
 
@@ -326,20 +756,466 @@ func generateMessagesImportDecl(loc *scope.Localization, decls *[]goast.Decl) {
 	}
 }
 
-func generateMessagesTypeDecl(loc *scope.Localization, decls *[]goast.Decl) {
-	typeDecl := &goast.GenDecl{
-		Tok: gotoken.TYPE,
-		Specs: []goast.Spec{
-			&goast.TypeSpec{
-				Name: goast.NewIdent(getLocalizerTypeName(loc)),
-				Type: &goast.StructType{
-					Fields: &goast.FieldList{},
-				},
-			},
-		},
-	}
+// Generates types for the localizer and its namespaces.
+// Namespace types embed the localizer type,
+// so they have access to its helper methods.
+func generateMessagesTypeDecl(loc *scope.Localization, namespaces []string, decls *[]goast.Decl) {
+	for _, namespace := range namespaces {
+		structType := &goast.StructType{
+			Fields: &goast.FieldList{},
+		}
+
+		if namespace != "" {
+			structType.Fields.List = append(structType.Fields.List, &goast.Field{
+				Type: goast.NewIdent(getLocalizerTypeName(loc)),
+			})
+		}
+
+		*decls = append(*decls, &goast.GenDecl{
+			Tok: gotoken.TYPE,
+			Specs: []goast.Spec{
+				&goast.TypeSpec{
+					Name: goast.NewIdent(getNamespaceTypeName(loc, namespace)),
+					Type: structType,
+				},
+			},
+		})
+	}
+}
+
+// Generates methods returning nested namespaces of each namespace.
+func generateMessagesNamespaceAccessors(loc *scope.Localization, namespaces []string, decls *[]goast.Decl) {
+	allNamespaces := getNamespaces(loc.Scopes)
+
+	for _, namespace := range namespaces {
+		for _, child := range getChildNamespaces(allNamespaces, namespace) {
+			*decls = append(*decls, &goast.FuncDecl{
+				Name: goast.NewIdent(getNamespaceAccessorName(child)),
+				Recv: &goast.FieldList{
+					List: []*goast.Field{
+						{
+							Names: []*goast.Ident{goast.NewIdent(getLocalizerName(loc))},
+							Type:  goast.NewIdent(getNamespaceTypeName(loc, namespace)),
+						},
+					},
+				},
+				Type: &goast.FuncType{
+					Params: &goast.FieldList{},
+					Results: &goast.FieldList{
+						List: []*goast.Field{
+							{Type: goast.NewIdent(getNamespaceInterfaceName(child))},
+						},
+					},
+				},
+				Body: &goast.BlockStmt{
+					List: []goast.Stmt{
+						&goast.ReturnStmt{
+							Results: []goast.Expr{
+								&goast.CompositeLit{
+									Type: goast.NewIdent(getNamespaceTypeName(loc, child)),
+								},
+							},
+						},
+					},
+				},
+			})
+		}
+	}
+}
+
+// Generates variable with the language tag of the localization
+// and the method returning it.
+func generateMessagesTagDecls(loc *scope.Localization, decls *[]goast.Decl) {
+	loc.AddImport(ast.GoImport{Import: "golang.org/x/text/language", Package: "language"})
+
+	*decls = append(*decls, &goast.GenDecl{
+		Tok: gotoken.VAR,
+		Specs: []goast.Spec{
+			&goast.ValueSpec{
+				Names: []*goast.Ident{goast.NewIdent(getLocalizerTagName(loc))},
+				Values: []goast.Expr{
+					&goast.CallExpr{
+						Fun: &goast.SelectorExpr{
+							X:   goast.NewIdent("language"),
+							Sel: goast.NewIdent("MustParse"),
+						},
+						Args: []goast.Expr{
+							&goast.BasicLit{
+								Kind:  gotoken.STRING,
+								Value: strconv.Quote(loc.Lang.String()),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	*decls = append(*decls, &goast.FuncDecl{
+		Name: goast.NewIdent("Tag"),
+		Recv: &goast.FieldList{
+			List: []*goast.Field{
+				{
+					Names: []*goast.Ident{goast.NewIdent(getLocalizerName(loc))},
+					Type:  goast.NewIdent(getLocalizerTypeName(loc)),
+				},
+			},
+		},
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Type: &goast.SelectorExpr{
+							X:   goast.NewIdent("language"),
+							Sel: goast.NewIdent("Tag"),
+						},
+					},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				&goast.ReturnStmt{
+					Results: []goast.Expr{goast.NewIdent(getLocalizerTagName(loc))},
+				},
+			},
+		},
+	})
+}
 
-	*decls = append(*decls, typeDecl)
+func generateMessagesPluralDecls(loc *scope.Localization, decls *[]goast.Decl) {
+	if len(loc.PluralFuncs) == 0 {
+		return
+	}
+
+	loc.AddImport(ast.GoImport{Import: "golang.org/x/text/feature/plural", Package: "plural"})
+
+	for _, fn := range loc.PluralFuncs {
+		if fn.Float {
+			generatePluralFloatFunc(loc, fn, decls)
+		} else {
+			generatePluralFunc(loc, fn, decls)
+		}
+	}
+}
+
+func generatePluralFunc(loc *scope.Localization, fn scope.PluralFunc, decls *[]goast.Decl) {
+	*decls = append(*decls, &goast.FuncDecl{
+		Name: goast.NewIdent(getPluralFuncName(fn)),
+		Recv: &goast.FieldList{
+			List: []*goast.Field{
+				{
+					Names: []*goast.Ident{goast.NewIdent(getLocalizerName(loc))},
+					Type:  goast.NewIdent(getLocalizerTypeName(loc)),
+				},
+			},
+		},
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{goast.NewIdent("n")},
+						Type:  goast.NewIdent("int"),
+					},
+				},
+			},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Type: &goast.SelectorExpr{
+							X:   goast.NewIdent("plural"),
+							Sel: goast.NewIdent("Form"),
+						},
+					},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				&goast.IfStmt{
+					Cond: &goast.BinaryExpr{
+						X:  goast.NewIdent("n"),
+						Op: gotoken.LSS,
+						Y: &goast.BasicLit{
+							Kind:  gotoken.INT,
+							Value: "0",
+						},
+					},
+					Body: &goast.BlockStmt{
+						List: []goast.Stmt{
+							&goast.AssignStmt{
+								Lhs: []goast.Expr{goast.NewIdent("n")},
+								Tok: gotoken.ASSIGN,
+								Rhs: []goast.Expr{
+									&goast.UnaryExpr{
+										Op: gotoken.SUB,
+										X:  goast.NewIdent("n"),
+									},
+								},
+							},
+						},
+					},
+				},
+				&goast.ReturnStmt{
+					Results: []goast.Expr{
+						&goast.CallExpr{
+							Fun: &goast.SelectorExpr{
+								X: &goast.SelectorExpr{
+									X:   goast.NewIdent("plural"),
+									Sel: goast.NewIdent(getPluralRulesName(fn.Type)),
+								},
+								Sel: goast.NewIdent("MatchPlural"),
+							},
+							Args: []goast.Expr{
+								goast.NewIdent(getLocalizerTagName(loc)),
+								goast.NewIdent("n"),
+								&goast.BasicLit{Kind: gotoken.INT, Value: "0"},
+								&goast.BasicLit{Kind: gotoken.INT, Value: "0"},
+								&goast.BasicLit{Kind: gotoken.INT, Value: "0"},
+								&goast.BasicLit{Kind: gotoken.INT, Value: "0"},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// Generates a method that returns plural form of n formatted with the precision prec
+// according to the plural rules of the localization language.
+// CLDR operands are computed from the decimal representation of n:
+// i is the integer part, f is the fractional part,
+// t is the fractional part without trailing zeros, v and w are their lengths.
+// Operands too large for int keep their low digits and stay large,
+// so that the rules comparing them to small numbers don't match.
+func generatePluralFloatFunc(loc *scope.Localization, fn scope.PluralFunc, decls *[]goast.Decl) {
+	loc.AddImport(ast.GoImport{Import: "math", Package: "math"})
+	loc.AddImport(ast.GoImport{Import: "strconv", Package: "strconv"})
+	loc.AddImport(ast.GoImport{Import: "strings", Package: "strings"})
+
+	ident := goast.NewIdent
+
+	call := func(pkg, fun string, args ...goast.Expr) *goast.CallExpr {
+		return &goast.CallExpr{
+			Fun: &goast.SelectorExpr{
+				X:   ident(pkg),
+				Sel: ident(fun),
+			},
+			Args: args,
+		}
+	}
+
+	operand := func(str string) goast.Expr {
+		return &goast.CallExpr{
+			Fun:  ident("operand"),
+			Args: []goast.Expr{ident(str)},
+		}
+	}
+
+	lenOf := func(str string) goast.Expr {
+		return &goast.CallExpr{
+			Fun:  ident("len"),
+			Args: []goast.Expr{ident(str)},
+		}
+	}
+
+	operandFunc := &goast.FuncLit{
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{ident("digits")},
+						Type:  ident("string"),
+					},
+				},
+			},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{ident("v")},
+						Type:  ident("int"),
+					},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				&goast.RangeStmt{
+					Key:   ident("_"),
+					Value: ident("d"),
+					Tok:   gotoken.DEFINE,
+					X:     ident("digits"),
+					Body: &goast.BlockStmt{
+						List: []goast.Stmt{
+							&goast.AssignStmt{
+								Lhs: []goast.Expr{ident("v")},
+								Tok: gotoken.ASSIGN,
+								Rhs: []goast.Expr{
+									&goast.BinaryExpr{
+										X: &goast.BinaryExpr{
+											X:  ident("v"),
+											Op: gotoken.MUL,
+											Y:  &goast.BasicLit{Kind: gotoken.INT, Value: "10"},
+										},
+										Op: gotoken.ADD,
+										Y: &goast.CallExpr{
+											Fun: ident("int"),
+											Args: []goast.Expr{
+												&goast.BinaryExpr{
+													X:  ident("d"),
+													Op: gotoken.SUB,
+													Y:  &goast.BasicLit{Kind: gotoken.CHAR, Value: "'0'"},
+												},
+											},
+										},
+									},
+								},
+							},
+							&goast.IfStmt{
+								Cond: &goast.BinaryExpr{
+									X:  ident("v"),
+									Op: gotoken.GEQ,
+									Y:  &goast.BasicLit{Kind: gotoken.FLOAT, Value: "1e17"},
+								},
+								Body: &goast.BlockStmt{
+									List: []goast.Stmt{
+										&goast.AssignStmt{
+											Lhs: []goast.Expr{ident("v")},
+											Tok: gotoken.ASSIGN,
+											Rhs: []goast.Expr{
+												&goast.BinaryExpr{
+													X: &goast.BinaryExpr{
+														X:  ident("v"),
+														Op: gotoken.REM,
+														Y:  &goast.BasicLit{Kind: gotoken.FLOAT, Value: "1e16"},
+													},
+													Op: gotoken.ADD,
+													Y:  &goast.BasicLit{Kind: gotoken.FLOAT, Value: "1e17"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&goast.ReturnStmt{
+					Results: []goast.Expr{ident("v")},
+				},
+			},
+		},
+	}
+
+	*decls = append(*decls, &goast.FuncDecl{
+		Name: ident(getPluralFuncName(fn)),
+		Recv: &goast.FieldList{
+			List: []*goast.Field{
+				{
+					Names: []*goast.Ident{ident(getLocalizerName(loc))},
+					Type:  ident(getLocalizerTypeName(loc)),
+				},
+			},
+		},
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{ident("n")},
+						Type:  ident("float64"),
+					},
+					{
+						Names: []*goast.Ident{ident("prec")},
+						Type:  ident("int"),
+					},
+				},
+			},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Type: &goast.SelectorExpr{
+							X:   ident("plural"),
+							Sel: ident("Form"),
+						},
+					},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				// Infinities and NaN don't have digits
+				&goast.IfStmt{
+					Cond: &goast.BinaryExpr{
+						X:  call("math", "IsInf", ident("n"), &goast.BasicLit{Kind: gotoken.INT, Value: "0"}),
+						Op: gotoken.LOR,
+						Y:  call("math", "IsNaN", ident("n")),
+					},
+					Body: &goast.BlockStmt{
+						List: []goast.Stmt{
+							&goast.ReturnStmt{
+								Results: []goast.Expr{
+									&goast.SelectorExpr{
+										X:   ident("plural"),
+										Sel: ident("Other"),
+									},
+								},
+							},
+						},
+					},
+				},
+				&goast.AssignStmt{
+					Lhs: []goast.Expr{ident("i"), ident("f"), ident("_")},
+					Tok: gotoken.DEFINE,
+					Rhs: []goast.Expr{
+						call("strings", "Cut",
+							call("strconv", "FormatFloat",
+								call("math", "Abs", ident("n")),
+								&goast.BasicLit{Kind: gotoken.CHAR, Value: "'f'"},
+								ident("prec"),
+								&goast.BasicLit{Kind: gotoken.INT, Value: "64"},
+							),
+							&goast.BasicLit{Kind: gotoken.STRING, Value: `"."`},
+						),
+					},
+				},
+				&goast.AssignStmt{
+					Lhs: []goast.Expr{ident("t")},
+					Tok: gotoken.DEFINE,
+					Rhs: []goast.Expr{
+						call("strings", "TrimRight", ident("f"), &goast.BasicLit{Kind: gotoken.STRING, Value: `"0"`}),
+					},
+				},
+				&goast.AssignStmt{
+					Lhs: []goast.Expr{ident("operand")},
+					Tok: gotoken.DEFINE,
+					Rhs: []goast.Expr{operandFunc},
+				},
+				&goast.ReturnStmt{
+					Results: []goast.Expr{
+						&goast.CallExpr{
+							Fun: &goast.SelectorExpr{
+								X: &goast.SelectorExpr{
+									X:   ident("plural"),
+									Sel: ident(getPluralRulesName(fn.Type)),
+								},
+								Sel: ident("MatchPlural"),
+							},
+							Args: []goast.Expr{
+								ident(getLocalizerTagName(loc)),
+								operand("i"),
+								lenOf("f"),
+								lenOf("t"),
+								operand("f"),
+								operand("t"),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
 }
 
 func generateMessage(loc *scope.Localization, ms *scope.MessageScope, decls *[]goast.Decl) {
@@ -353,7 +1229,7 @@ func generateMessage(loc *scope.Localization, ms *scope.MessageScope, decls *[]g
 			List: []*goast.Field{
 				{
 					Names: []*goast.Ident{goast.NewIdent(getLocalizerName(loc))},
-					Type:  goast.NewIdent(getLocalizerTypeName(loc)),
+					Type:  goast.NewIdent(getNamespaceTypeName(loc, ms.Namespace)),
 				},
 			},
 		},
@@ -395,7 +1271,7 @@ func generateMessage(loc *scope.Localization, ms *scope.MessageScope, decls *[]g
 		})
 	}
 
-	values := []ast.Value{&ms.Plural, ms.String}
+	values := []ast.Value{&ms.Plural, &ms.Ordinal, &ms.Select, &ms.When, &ms.Range, ms.String}
 
 	for _, val := range values {
 		if !val.IsZero() {
@@ -422,6 +1298,56 @@ func generateMessage(loc *scope.Localization, ms *scope.MessageScope, decls *[]g
 	*decls = append(*decls, funcDecl)
 }
 
+// Generates method calling the same method of the localization
+// the message is delegated to.
+func generateDelegatedMessage(loc *scope.Localization, ms *scope.MessageScope, decls *[]goast.Decl) {
+	callExpr := &goast.CallExpr{
+		Fun: &goast.SelectorExpr{
+			X: &goast.CompositeLit{
+				Type: goast.NewIdent(getNamespaceTypeName(ms.Delegate, ms.Namespace)),
+			},
+			Sel: goast.NewIdent(getMessageFuncName(ms)),
+		},
+	}
+
+	funcDecl := &goast.FuncDecl{
+		Name: goast.NewIdent(getMessageFuncName(ms)),
+		Recv: &goast.FieldList{
+			List: []*goast.Field{
+				{
+					Names: []*goast.Ident{goast.NewIdent(getLocalizerName(loc))},
+					Type:  goast.NewIdent(getNamespaceTypeName(loc, ms.Namespace)),
+				},
+			},
+		},
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{Type: goast.NewIdent("string")},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				&goast.ReturnStmt{
+					Results: []goast.Expr{callExpr},
+				},
+			},
+		},
+	}
+
+	for i := 0; i < len(ms.Arguments); i++ {
+		funcDecl.Type.Params.List = append(funcDecl.Type.Params.List, &goast.Field{
+			Names: []*goast.Ident{goast.NewIdent(ms.Arguments[i].Name)},
+			Type:  getPackageFieldType(&ms.Arguments[i]),
+		})
+		callExpr.Args = append(callExpr.Args, goast.NewIdent(ms.Arguments[i].Name))
+	}
+
+	*decls = append(*decls, funcDecl)
+}
+
 func generateSimpleMessage(loc *scope.Localization, ms *scope.MessageScope, decls *[]goast.Decl) {
 	funcDecl := &goast.FuncDecl{
 		Name: goast.NewIdent(getMessageFuncName(ms)),
@@ -429,7 +1355,7 @@ func generateSimpleMessage(loc *scope.Localization, ms *scope.MessageScope, decl
 			List: []*goast.Field{
 				{
 					Names: []*goast.Ident{goast.NewIdent(getLocalizerName(loc))},
-					Type:  goast.NewIdent(getLocalizerTypeName(loc)),
+					Type:  goast.NewIdent(getNamespaceTypeName(loc, ms.Namespace)),
 				},
 			},
 		},
@@ -453,7 +1379,7 @@ func generateSimpleMessage(loc *scope.Localization, ms *scope.MessageScope, decl
 		})
 	}
 
-	values := []ast.Value{&ms.Plural, ms.String}
+	values := []ast.Value{&ms.Plural, &ms.Ordinal, &ms.Select, &ms.When, &ms.Range, ms.String}
 
 	for _, val := range values {
 		if !val.IsZero() {
@@ -469,53 +1395,311 @@ func generateSimpleMessage(loc *scope.Localization, ms *scope.MessageScope, decl
 	*decls = append(*decls, funcDecl)
 }
 
-func generatePlural(
+func generatePlural(
+	loc *scope.Localization,
+	ms *scope.MessageScope,
+	plural *ast.Plural,
+	builderName string,
+	list *[]goast.Stmt,
+) {
+	if len(plural.Exact) == 0 {
+		generatePluralForms(loc, ms, plural, builderName, list)
+		return
+	}
+
+	switchStmt := &goast.SwitchStmt{
+		Tag:  goast.NewIdent(plural.Arg),
+		Body: &goast.BlockStmt{},
+	}
+
+	for i := 0; i < len(plural.Exact); i++ {
+		exact := &plural.Exact[i]
+
+		caseClause := &goast.CaseClause{
+			List: []goast.Expr{
+				&goast.BasicLit{
+					Kind:  gotoken.INT,
+					Value: strconv.Itoa(exact.Value),
+				},
+			},
+		}
+
+		generateValue(loc, ms, exact.Format, builderName, &caseClause.Body)
+		switchStmt.Body.List = append(switchStmt.Body.List, caseClause)
+	}
+
+	defaultClause := &goast.CaseClause{}
+	generatePluralForms(loc, ms, plural, builderName, &defaultClause.Body)
+	switchStmt.Body.List = append(switchStmt.Body.List, defaultClause)
+
+	*list = append(*list, switchStmt)
+}
+
+// Generates a switch over plural categories.
+// If only "other" category is specified, its value is generated as is.
+func generatePluralForms(
+	loc *scope.Localization,
+	ms *scope.MessageScope,
+	plural *ast.Plural,
+	builderName string,
+	list *[]goast.Stmt,
+) {
+	values := []struct {
+		Value ast.Value
+		Form  string
+	}{
+		{plural.Zero, "Zero"},
+		{plural.One, "One"},
+		{plural.Two, "Two"},
+		{plural.Few, "Few"},
+		{plural.Many, "Many"},
+	}
+
+	fn := scope.PluralFunc{
+		Type:  plural.Type,
+		Float: plural.IsFloat(),
+	}
+
+	callExpr := &goast.CallExpr{
+		Fun: &goast.SelectorExpr{
+			X:   goast.NewIdent(getLocalizerName(loc)),
+			Sel: goast.NewIdent(getPluralFuncName(fn)),
+		},
+		Args: []goast.Expr{
+			getPluralNumberExpr(plural.Arg, plural.Offset),
+		},
+	}
+
+	// Without precision, the shortest representation of the number is used
+	if fn.Float {
+		prec := -1
+		if plural.FmtInfo.Prec.Valid {
+			prec = plural.FmtInfo.Prec.Value
+		}
+
+		callExpr.Args = append(callExpr.Args, &goast.BasicLit{
+			Kind:  gotoken.INT,
+			Value: strconv.Itoa(prec),
+		})
+	}
+
+	switchStmt := &goast.SwitchStmt{
+		Tag:  callExpr,
+		Body: &goast.BlockStmt{},
+	}
+
+	for _, value := range values {
+		if value.Value.IsZero() {
+			continue
+		}
+
+		caseClause := &goast.CaseClause{
+			List: []goast.Expr{
+				&goast.SelectorExpr{
+					X:   goast.NewIdent("plural"),
+					Sel: goast.NewIdent(value.Form),
+				},
+			},
+		}
+
+		generateValue(loc, ms, value.Value, builderName, &caseClause.Body)
+		switchStmt.Body.List = append(switchStmt.Body.List, caseClause)
+	}
+
+	if len(switchStmt.Body.List) == 0 {
+		generateValue(loc, ms, plural.Other, builderName, list)
+		return
+	}
+
+	loc.AddImport(ast.GoImport{Import: "golang.org/x/text/feature/plural", Package: "plural"})
+	loc.AddPluralFunc(fn)
+
+	defaultClause := &goast.CaseClause{}
+	generateValue(loc, ms, plural.Other, builderName, &defaultClause.Body)
+	switchStmt.Body.List = append(switchStmt.Body.List, defaultClause)
+
+	*list = append(*list, switchStmt)
+}
+
+func generateSelect(
+	loc *scope.Localization,
+	ms *scope.MessageScope,
+	sel *ast.Select,
+	builderName string,
+	list *[]goast.Stmt,
+) {
+	if len(sel.Cases) == 0 {
+		generateValue(loc, ms, sel.Other, builderName, list)
+		return
+	}
+
+	// Gender of the term is known at generation time,
+	// so the branch is selected right away
+	if sel.Term != "" {
+		termLoc := getTermLocalization(loc, ms)
+		term := &termLoc.Terms[scope.FileTermScopeIndex(termLoc.Terms, ms.File, sel.Term)]
+
+		for i := 0; i < len(sel.Cases); i++ {
+			if c := &sel.Cases[i]; c.Key == term.Gender {
+				generateValue(loc, ms, c.Format, builderName, list)
+				return
+			}
+		}
+
+		generateValue(loc, ms, sel.Other, builderName, list)
+		return
+	}
+
+	switchStmt := &goast.SwitchStmt{
+		Tag:  goast.NewIdent(sel.Arg),
+		Body: &goast.BlockStmt{},
+	}
+
+	for i := 0; i < len(sel.Cases); i++ {
+		c := &sel.Cases[i]
+
+		var key goast.Expr
+
+		if sel.Type.IsZero() {
+			key = &goast.BasicLit{
+				Kind:  gotoken.STRING,
+				Value: strconv.Quote(c.Key),
+			}
+		} else {
+			key = &goast.SelectorExpr{
+				X:   goast.NewIdent(sel.Type.Package),
+				Sel: goast.NewIdent(c.Key),
+			}
+		}
+
+		caseClause := &goast.CaseClause{
+			List: []goast.Expr{key},
+		}
+
+		generateValue(loc, ms, c.Format, builderName, &caseClause.Body)
+		switchStmt.Body.List = append(switchStmt.Body.List, caseClause)
+	}
+
+	*list = append(*list, switchStmt)
+
+	if sel.Other != nil {
+		defaultClause := &goast.CaseClause{}
+		generateValue(loc, ms, sel.Other, builderName, &defaultClause.Body)
+		switchStmt.Body.List = append(switchStmt.Body.List, defaultClause)
+		return
+	}
+
+	// Exhaustive select without "other" is not a terminating statement
+	if builderName == "" {
+		*list = append(*list, &goast.ReturnStmt{
+			Results: []goast.Expr{
+				&goast.BasicLit{
+					Kind:  gotoken.STRING,
+					Value: `""`,
+				},
+			},
+		})
+	}
+}
+
+func generateWhen(
+	loc *scope.Localization,
+	ms *scope.MessageScope,
+	when *ast.When,
+	builderName string,
+	list *[]goast.Stmt,
+) {
+	ifStmt := &goast.IfStmt{
+		Cond: goast.NewIdent(when.Arg),
+		Body: &goast.BlockStmt{},
+	}
+
+	generateValue(loc, ms, when.True, builderName, &ifStmt.Body.List)
+	*list = append(*list, ifStmt)
+
+	if when.False != nil {
+		elseStmt := &goast.BlockStmt{}
+		generateValue(loc, ms, when.False, builderName, &elseStmt.List)
+		ifStmt.Else = elseStmt
+		return
+	}
+
+	// If statement without else is not a terminating statement
+	if builderName == "" {
+		*list = append(*list, &goast.ReturnStmt{
+			Results: []goast.Expr{
+				&goast.BasicLit{
+					Kind:  gotoken.STRING,
+					Value: `""`,
+				},
+			},
+		})
+	}
+}
+
+func generateRange(
 	loc *scope.Localization,
 	ms *scope.MessageScope,
-	plural *ast.Plural,
+	rng *ast.Range,
 	builderName string,
 	list *[]goast.Stmt,
 ) {
-	values := []struct {
-		Value  ast.Value
-		Op     gotoken.Token
-		Number string
-	}{
-		{plural.Zero, gotoken.EQL, "0"},
-		{plural.One, gotoken.EQL, "1"},
-		{plural.Many, gotoken.GTR, "1"},
-		{plural.Other, gotoken.ILLEGAL, ""},
-	}
-
 	switchStmt := &goast.SwitchStmt{
 		Body: &goast.BlockStmt{},
 	}
 
-	for _, value := range values {
-		if value.Value.IsZero() {
-			continue
-		}
-
-		caseClause := &goast.CaseClause{}
+	for i := 0; i < len(rng.Cases); i++ {
+		c := &rng.Cases[i]
 
-		if value.Op != gotoken.ILLEGAL {
-			caseClause.List = []goast.Expr{
-				&goast.BinaryExpr{
-					X:  goast.NewIdent(plural.Arg),
-					Op: value.Op,
-					Y: &goast.BasicLit{
-						Kind:  gotoken.INT,
-						Value: value.Number,
-					},
-				},
-			}
+		caseClause := &goast.CaseClause{
+			List: []goast.Expr{getRangeCondExpr(rng.Arg, c)},
 		}
 
-		generateValue(loc, ms, value.Value, builderName, &caseClause.Body)
+		generateValue(loc, ms, c.Format, builderName, &caseClause.Body)
 		switchStmt.Body.List = append(switchStmt.Body.List, caseClause)
 	}
 
+	defaultClause := &goast.CaseClause{}
 	*list = append(*list, switchStmt)
+
+	// Ranges cover all the values, so the last one becomes the default case
+	if rng.Other == nil {
+		lastIdx := len(switchStmt.Body.List) - 1
+		defaultClause.Body = switchStmt.Body.List[lastIdx].(*goast.CaseClause).Body
+		switchStmt.Body.List[lastIdx] = defaultClause
+		return
+	}
+
+	generateValue(loc, ms, rng.Other, builderName, &defaultClause.Body)
+	switchStmt.Body.List = append(switchStmt.Body.List, defaultClause)
+}
+
+func getRangeCondExpr(arg string, c *ast.RangeCase) goast.Expr {
+	bound := func(op gotoken.Token, value int) goast.Expr {
+		return &goast.BinaryExpr{
+			X:  goast.NewIdent(arg),
+			Op: op,
+			Y: &goast.BasicLit{
+				Kind:  gotoken.INT,
+				Value: strconv.Itoa(value),
+			},
+		}
+	}
+
+	switch {
+	case !c.Min.Valid:
+		return bound(gotoken.LEQ, c.Max.Value)
+	case !c.Max.Valid:
+		return bound(gotoken.GEQ, c.Min.Value)
+	case c.Min.Value == c.Max.Value:
+		return bound(gotoken.EQL, c.Min.Value)
+	default:
+		return &goast.BinaryExpr{
+			X:  bound(gotoken.GEQ, c.Min.Value),
+			Op: gotoken.LAND,
+			Y:  bound(gotoken.LEQ, c.Max.Value),
+		}
+	}
 }
 
 func generateFormatParts(
@@ -538,8 +1722,20 @@ func generateFormatParts(
 			idx := scope.ArgumentIndex(ms.Arguments, part.Name)
 			generateArgument(loc, ms, &ms.Arguments[idx], &part, builderName, list)
 		case ast.VarInfo:
+			if part.Term {
+				termLoc := getTermLocalization(loc, ms)
+				idx := scope.FileTermScopeIndex(termLoc.Terms, ms.File, part.Name)
+				generateTermCall(loc, termLoc, &termLoc.Terms[idx], part.Form, builderName, list)
+				continue
+			}
+
 			idx := scope.VariableScopeIndex(ms.Variables, part.Name)
 			generateVariableCall(loc, ms, &ms.Variables[idx], builderName, list)
+		case ast.MsgInfo:
+			idx := scope.MessageScopeIndex(loc.Scopes, part.Name)
+			generateMessageCall(loc, ms, &loc.Scopes[idx], builderName, list)
+		case ast.NumberInfo:
+			generateNumber(loc, ms, &part, builderName, list)
 		}
 	}
 }
@@ -604,7 +1800,8 @@ func generateArgument(
 		return
 	}
 
-	if arg.GoType.Type == "any" {
+	// Arguments of user types are written using fmt
+	if arg.GoType.Type == "any" || !slices.Contains(common.Config.SpecifierToGoType[:], arg.GoType) {
 		generateArgumentAny(loc, arg, builderName, list)
 		return
 	}
@@ -627,11 +1824,82 @@ func generateArgument(
 		generateArgumentItoa(loc, arg, callExpr)
 	case "float64":
 		generateArgumentFormatFloat(loc, arg, callExpr)
+	case "bool":
+		generateArgumentFormatBool(loc, arg, callExpr)
 	case "Stringer":
 		generateArgumentStringer(loc, arg, callExpr)
 	}
 }
 
+func generateNumber(
+	loc *scope.Localization,
+	_ *scope.MessageScope,
+	info *ast.NumberInfo,
+	builderName string,
+	list *[]goast.Stmt,
+) {
+	number := getPluralNumberExpr(info.Arg, info.Offset)
+
+	goType := common.Config.SpecifierToGoType['d']
+	if info.Float {
+		goType = common.Config.SpecifierToGoType['f']
+	}
+
+	if info.FmtInfo.HasOptions() {
+		loc.AddImport(ast.GoImport{Import: "fmt", Package: "fmt"})
+		fmtStr := info.FmtInfo.GoFormat(goType)
+
+		*list = append(*list, &goast.ExprStmt{
+			X: &goast.CallExpr{
+				Fun: &goast.SelectorExpr{
+					X:   goast.NewIdent("fmt"),
+					Sel: goast.NewIdent("Fprintf"),
+				},
+				Args: []goast.Expr{
+					goast.NewIdent(builderName),
+					&goast.BasicLit{
+						Kind:  gotoken.STRING,
+						Value: strconv.Quote(fmtStr),
+					},
+					number,
+				},
+			},
+		})
+
+		return
+	}
+
+	loc.AddImport(ast.GoImport{Import: "strconv", Package: "strconv"})
+
+	convExpr := &goast.CallExpr{
+		Fun: &goast.SelectorExpr{
+			X:   goast.NewIdent("strconv"),
+			Sel: goast.NewIdent("Itoa"),
+		},
+		Args: []goast.Expr{number},
+	}
+
+	// Float numbers without precision are written in the shortest representation
+	if info.Float {
+		convExpr.Fun.(*goast.SelectorExpr).Sel = goast.NewIdent("FormatFloat")
+		convExpr.Args = append(convExpr.Args,
+			&goast.BasicLit{Kind: gotoken.CHAR, Value: `'f'`},
+			&goast.BasicLit{Kind: gotoken.INT, Value: `-1`},
+			&goast.BasicLit{Kind: gotoken.INT, Value: `64`},
+		)
+	}
+
+	*list = append(*list, &goast.ExprStmt{
+		X: &goast.CallExpr{
+			Fun: &goast.SelectorExpr{
+				X:   goast.NewIdent(builderName),
+				Sel: goast.NewIdent("WriteString"),
+			},
+			Args: []goast.Expr{convExpr},
+		},
+	})
+}
+
 func generateArgumentStringer(_ *scope.Localization, arg *scope.Argument, callExpr *goast.CallExpr) {
 	callExpr.Args = []goast.Expr{
 		&goast.CallExpr{
@@ -686,6 +1954,19 @@ func generateArgumentItoa(loc *scope.Localization, arg *scope.Argument, callExpr
 	}
 }
 
+func generateArgumentFormatBool(loc *scope.Localization, arg *scope.Argument, callExpr *goast.CallExpr) {
+	loc.AddImport(ast.GoImport{Import: "strconv", Package: "strconv"})
+	callExpr.Args = []goast.Expr{
+		&goast.CallExpr{
+			Fun: &goast.SelectorExpr{
+				X:   goast.NewIdent("strconv"),
+				Sel: goast.NewIdent("FormatBool"),
+			},
+			Args: []goast.Expr{goast.NewIdent(arg.Name)},
+		},
+	}
+}
+
 func generateArgumentFormatFloat(loc *scope.Localization, arg *scope.Argument, callExpr *goast.CallExpr) {
 	loc.AddImport(ast.GoImport{Import: "strconv", Package: "strconv"})
 	callExpr.Args = []goast.Expr{
@@ -789,7 +2070,7 @@ func generateVariableFunc(
 			List: []*goast.Field{
 				{
 					Names: []*goast.Ident{goast.NewIdent(getLocalizerName(loc))},
-					Type:  goast.NewIdent(getLocalizerTypeName(loc)),
+					Type:  goast.NewIdent(getNamespaceTypeName(loc, ms.Namespace)),
 				},
 			},
 		},
@@ -801,7 +2082,14 @@ func generateVariableFunc(
 		Body: &goast.BlockStmt{},
 	}
 
-	values := []ast.Value{&variable.Plural, variable.String}
+	values := []ast.Value{
+		&variable.Plural,
+		&variable.Ordinal,
+		&variable.Select,
+		&variable.When,
+		&variable.Range,
+		variable.String,
+	}
 
 	for _, value := range values {
 		if value.IsZero() {
@@ -822,6 +2110,189 @@ func generateVariableFunc(
 	*decls = append(*decls, funcDecl)
 }
 
+func generateMessageCall(
+	loc *scope.Localization,
+	caller *scope.MessageScope,
+	ms *scope.MessageScope,
+	builderName string,
+	list *[]goast.Stmt,
+) {
+	var recv goast.Expr = goast.NewIdent(getLocalizerName(loc))
+
+	// Messages from other namespaces are accessed from the localizer
+	if ms.Namespace != caller.Namespace {
+		recv = &goast.CompositeLit{
+			Type: goast.NewIdent(getLocalizerTypeName(loc)),
+		}
+
+		if ms.Namespace != "" {
+			for _, part := range strings.Split(ms.Namespace, ast.NamespaceSeparator) {
+				recv = &goast.CallExpr{
+					Fun: &goast.SelectorExpr{
+						X:   recv,
+						Sel: goast.NewIdent(part),
+					},
+				}
+			}
+		}
+	}
+
+	callExpr := &goast.CallExpr{
+		Fun: &goast.SelectorExpr{
+			X:   recv,
+			Sel: goast.NewIdent(getMessageFuncName(ms)),
+		},
+	}
+
+	for i := 0; i < len(ms.Arguments); i++ {
+		callExpr.Args = append(callExpr.Args, goast.NewIdent(ms.Arguments[i].Name))
+	}
+
+	*list = append(*list, &goast.ExprStmt{
+		X: &goast.CallExpr{
+			Fun: &goast.SelectorExpr{
+				X:   goast.NewIdent(builderName),
+				Sel: goast.NewIdent("WriteString"),
+			},
+			Args: []goast.Expr{callExpr},
+		},
+	})
+}
+
+// Generates call of the term function of the localization containing the term,
+// which is another localization for the inherited messages.
+func generateTermCall(
+	loc *scope.Localization,
+	termLoc *scope.Localization,
+	term *scope.TermScope,
+	form string,
+	builderName string,
+	list *[]goast.Stmt,
+) {
+	var recv goast.Expr = goast.NewIdent(getLocalizerName(loc))
+
+	if termLoc != loc {
+		recv = &goast.CompositeLit{
+			Type: goast.NewIdent(getLocalizerTypeName(termLoc)),
+		}
+	}
+
+	callExpr := &goast.CallExpr{
+		Fun: &goast.SelectorExpr{
+			X:   recv,
+			Sel: goast.NewIdent(getTermFuncName(term, form)),
+		},
+		Args: []goast.Expr{
+			goast.NewIdent(builderName),
+		},
+	}
+
+	for i := 0; i < len(term.Arguments); i++ {
+		callExpr.Args = append(callExpr.Args, goast.NewIdent(term.Arguments[i].Name))
+	}
+
+	*list = append(*list, &goast.ExprStmt{
+		X: callExpr,
+	})
+}
+
+// Generates function for the term value or, if form is not empty, for the term form.
+// Value and all the forms take all the arguments of the term.
+func generateTermFunc(
+	loc *scope.Localization,
+	term *scope.TermScope,
+	value *scope.MessageScope,
+	form string,
+	decls *[]goast.Decl,
+) {
+	const builderName = "b0"
+
+	loc.AddImport(ast.GoImport{Import: "strings", Package: "strings"})
+
+	builderField := &goast.Field{
+		Names: []*goast.Ident{
+			goast.NewIdent(builderName),
+		},
+		Type: &goast.StarExpr{
+			X: &goast.SelectorExpr{
+				X:   goast.NewIdent("strings"),
+				Sel: goast.NewIdent("Builder"),
+			},
+		},
+	}
+
+	funcDecl := &goast.FuncDecl{
+		Name: goast.NewIdent(getTermFuncName(term, form)),
+		Recv: &goast.FieldList{
+			List: []*goast.Field{
+				{
+					Names: []*goast.Ident{goast.NewIdent(getLocalizerName(loc))},
+					Type:  goast.NewIdent(getLocalizerTypeName(loc)),
+				},
+			},
+		},
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{builderField},
+			},
+		},
+		Body: &goast.BlockStmt{},
+	}
+
+	for i := 0; i < len(term.Arguments); i++ {
+		funcDecl.Type.Params.List = append(funcDecl.Type.Params.List, &goast.Field{
+			Names: []*goast.Ident{goast.NewIdent(term.Arguments[i].Name)},
+			Type:  getPackageFieldType(&term.Arguments[i]),
+		})
+	}
+
+	values := []ast.Value{&value.Plural, &value.Ordinal, &value.Select, &value.When, &value.Range, value.String}
+
+	for _, val := range values {
+		if !val.IsZero() {
+			generateValue(loc, value, val, builderName, &funcDecl.Body.List)
+			break
+		}
+	}
+
+	*decls = append(*decls, funcDecl)
+}
+
+// Returns imports required by argument types.
+func getArgumentImports(msgs []scope.MessageScope) (imports []ast.GoImport) {
+	for i := 0; i < len(msgs); i++ {
+		for j := 0; j < len(msgs[i].Arguments); j++ {
+			goType := &msgs[i].Arguments[j].GoType
+			if goType.Import == "" {
+				continue
+			}
+
+			imp := ast.GoImport{Import: goType.Import, Package: goType.Package}
+			if !slices.Contains(imports, imp) {
+				imports = append(imports, imp)
+			}
+		}
+	}
+
+	return imports
+}
+
+// Returns expression for the plural argument with the offset subtracted from it.
+func getPluralNumberExpr(arg string, offset int) goast.Expr {
+	if offset == 0 {
+		return goast.NewIdent(arg)
+	}
+
+	return &goast.BinaryExpr{
+		X:  goast.NewIdent(arg),
+		Op: gotoken.SUB,
+		Y: &goast.BasicLit{
+			Kind:  gotoken.INT,
+			Value: strconv.Itoa(offset),
+		},
+	}
+}
+
 func getPackageFieldType(arg *scope.Argument) goast.Expr {
 	if arg.GoType.Package == "" {
 		return goast.NewIdent(arg.GoType.Type)
@@ -833,12 +2304,131 @@ func getPackageFieldType(arg *scope.Argument) goast.Expr {
 	}
 }
 
+// Returns the language tag of the localization
+// usable as a part of Go identifiers, e.g. pt_BR for pt-BR.
+// Tags never contain underscores, so different tags
+// always result in different identifiers.
+func getLangIdent(loc *scope.Localization) string {
+	return strings.ReplaceAll(loc.Lang.String(), "-", "_")
+}
+
 func getLocalizerName(loc *scope.Localization) string {
-	return loc.Lang.String() + "_l"
+	return getLangIdent(loc) + "_l"
 }
 
 func getLocalizerTypeName(loc *scope.Localization) string {
-	return loc.Lang.String() + "_Localizer"
+	return getLangIdent(loc) + "_Localizer"
+}
+
+func getNamespaceTypeName(loc *scope.Localization, namespace string) string {
+	if namespace == "" {
+		return getLocalizerTypeName(loc)
+	}
+	return getLangIdent(loc) + "_" + strings.ReplaceAll(namespace, ast.NamespaceSeparator, "") + "Localizer"
+}
+
+func getNamespaceInterfaceName(namespace string) string {
+	return strings.ReplaceAll(namespace, ast.NamespaceSeparator, "") + "Localizer"
+}
+
+// Returns name of the method returning the namespace,
+// which is the last part of the namespace.
+func getNamespaceAccessorName(namespace string) string {
+	return namespace[strings.LastIndex(namespace, ast.NamespaceSeparator)+1:]
+}
+
+// Returns sorted namespaces of the messages along with their parents,
+// except for the root one.
+func getNamespaces(msgs []scope.MessageScope) (namespaces []string) {
+	for i := 0; i < len(msgs); i++ {
+		if msgs[i].Namespace == "" {
+			continue
+		}
+
+		parts := strings.Split(msgs[i].Namespace, ast.NamespaceSeparator)
+		for j := 1; j <= len(parts); j++ {
+			namespace := strings.Join(parts[:j], ast.NamespaceSeparator)
+			if !slices.Contains(namespaces, namespace) {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+	}
+
+	slices.Sort(namespaces)
+
+	return namespaces
+}
+
+// Returns the first part of the namespace.
+func getTopNamespace(namespace string) string {
+	top, _, _ := strings.Cut(namespace, ast.NamespaceSeparator)
+	return top
+}
+
+// Returns name of the file containing messages of the top-level namespace,
+// converting the namespace name to snake case.
+func getNamespaceFileName(namespace string) string {
+	var sb strings.Builder
+
+	for i, r := range namespace {
+		if unicode.IsUpper(r) {
+			if i != 0 {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// Returns namespaces directly nested in the parent namespace.
+func getChildNamespaces(namespaces []string, parent string) (children []string) {
+	for _, namespace := range namespaces {
+		if namespace == "" {
+			continue
+		}
+
+		idx := strings.LastIndex(namespace, ast.NamespaceSeparator)
+		if (idx == -1 && parent == "") || (idx != -1 && namespace[:idx] == parent) {
+			children = append(children, namespace)
+		}
+	}
+
+	return children
+}
+
+func getLocalizerTagName(loc *scope.Localization) string {
+	return getLangIdent(loc) + "_tag"
+}
+
+func getPluralFuncName(fn scope.PluralFunc) string {
+	var name string
+
+	switch fn.Type {
+	case ast.PluralCardinal:
+		name = "cardinal"
+	case ast.PluralOrdinal:
+		name = "ordinal"
+	}
+
+	if fn.Float {
+		name += "Float"
+	}
+
+	return name
+}
+
+func getPluralRulesName(typ ast.PluralType) string {
+	switch typ {
+	case ast.PluralCardinal:
+		return "Cardinal"
+	case ast.PluralOrdinal:
+		return "Ordinal"
+	default:
+		return ""
+	}
 }
 
 func getMessageFuncName(ms *scope.MessageScope) string {
@@ -849,6 +2439,25 @@ func getVariableFuncName(ms *scope.MessageScope, variable *scope.VariableScope)
 	return ms.Name + "_" + variable.Name
 }
 
+// Returns the localization containing the terms referenced by the message,
+// which is the localization the message is inherited from, if any.
+func getTermLocalization(loc *scope.Localization, ms *scope.MessageScope) *scope.Localization {
+	if ms.Inherited != nil {
+		return ms.Inherited
+	}
+	return loc
+}
+
+// Returns name of the term function, which includes the index of the file of the term,
+// since terms with the same name can be defined in different files.
+func getTermFuncName(term *scope.TermScope, form string) string {
+	name := "term_" + strconv.Itoa(term.File.Index) + "_" + term.Name
+	if form == "" {
+		return name
+	}
+	return name + "_" + form
+}
+
 func generateValue(
 	loc *scope.Localization,
 	ms *scope.MessageScope,
@@ -859,6 +2468,12 @@ func generateValue(
 	switch v := value.(type) {
 	case *ast.Plural:
 		generatePlural(loc, ms, v, builderName, list)
+	case *ast.Select:
+		generateSelect(loc, ms, v, builderName, list)
+	case *ast.When:
+		generateWhen(loc, ms, v, builderName, list)
+	case *ast.Range:
+		generateRange(loc, ms, v, builderName, list)
 	case ast.FormatParts:
 		generateFormatParts(loc, ms, v, builderName, list)
 	}
@@ -0,0 +1,57 @@
+package codegen
+
+// Source of the file with the function choosing the localizer
+// by the POSIX locale environment variables.
+// LC_ALL, LC_MESSAGES and LANG are checked in this order,
+// and the list of languages from LANGUAGE takes precedence,
+// but is ignored for C locale as in gettext.
+const envSource = `import (
+	"os"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Converts locale names like ru_RU.UTF-8 to language tags.
+// C and POSIX locales don't have a language.
+func parseLocale(locale string) (tag language.Tag, ok bool) {
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(locale, "@")
+
+	if locale == "" || locale == "C" || locale == "POSIX" {
+		return language.Und, false
+	}
+
+	tag, err := language.Parse(locale)
+
+	return tag, err == nil
+}
+
+// FromEnv returns the localizer best matching the locale
+// from LC_ALL, LC_MESSAGES or LANG environment variables
+// and the list of languages from LANGUAGE environment variable.
+func FromEnv() Localizer {
+	locale := os.Getenv("LC_ALL")
+	if locale == "" {
+		locale = os.Getenv("LC_MESSAGES")
+	}
+	if locale == "" {
+		locale = os.Getenv("LANG")
+	}
+
+	tag, ok := parseLocale(locale)
+	if !ok {
+		return Match()
+	}
+
+	var tags []language.Tag
+
+	for _, lang := range strings.Split(os.Getenv("LANGUAGE"), ":") {
+		if tag, ok := parseLocale(lang); ok {
+			tags = append(tags, tag)
+		}
+	}
+
+	return Match(append(tags, tag)...)
+}
+`
@@ -0,0 +1,90 @@
+package codegen
+
+// Source of the file with the functions storing localizers in context.Context
+// and net/http middleware choosing localizers for requests.
+// Names of the query parameter and the cookie are passed as options,
+// so that different handlers can use different names.
+const httpSource = `import (
+	"context"
+	"net/http"
+
+	"golang.org/x/text/language"
+)
+
+type contextKey struct{}
+
+type requestConfig struct {
+	queryParam string
+	cookieName string
+}
+
+// MiddlewareOption configures FromRequest and Middleware.
+type MiddlewareOption func(c *requestConfig)
+
+// WithQueryParam sets the name of the query parameter containing the language,
+// which is "lang" by default. Empty name disables the query parameter.
+func WithQueryParam(name string) MiddlewareOption {
+	return func(c *requestConfig) {
+		c.queryParam = name
+	}
+}
+
+// WithCookieName sets the name of the cookie containing the language,
+// which is "lang" by default. Empty name disables the cookie.
+func WithCookieName(name string) MiddlewareOption {
+	return func(c *requestConfig) {
+		c.cookieName = name
+	}
+}
+
+func WithLocalizer(ctx context.Context, loc Localizer) context.Context {
+	return context.WithValue(ctx, contextKey{}, loc)
+}
+
+// FromContext returns the localizer stored in the context
+// or the base localizer if there's none.
+func FromContext(ctx context.Context) Localizer {
+	if loc, ok := ctx.Value(contextKey{}).(Localizer); ok {
+		return loc
+	}
+	return Match()
+}
+
+// FromRequest chooses the localizer by the query parameter,
+// the cookie and Accept-Language header, in this order.
+func FromRequest(r *http.Request, opts ...MiddlewareOption) Localizer {
+	c := requestConfig{
+		queryParam: "lang",
+		cookieName: "lang",
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	var query, cookie string
+
+	if c.queryParam != "" {
+		query = r.URL.Query().Get(c.queryParam)
+	}
+
+	if c.cookieName != "" {
+		if ck, err := r.Cookie(c.cookieName); err == nil {
+			cookie = ck.Value
+		}
+	}
+
+	_, idx := language.MatchStrings(matcher, query, cookie, r.Header.Get("Accept-Language"))
+
+	return mapLangToLocalizer[Supported[idx]]
+}
+
+// Middleware stores the localizer chosen by FromRequest in the context of the request.
+func Middleware(next http.Handler, opts ...MiddlewareOption) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithLocalizer(r.Context(), FromRequest(r, opts...))
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+`
@@ -0,0 +1,97 @@
+package codegen
+
+// Source of the file with the type of supported languages,
+// which can be used in flags, configs and databases.
+// Empty language means that the language is not specified.
+const langSource = `import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+var ErrUnsupportedLanguage = errors.New("unsupported language")
+
+// Lang is one of the supported languages or empty string.
+type Lang string
+
+// ParseLang converts the string to one of the supported languages.
+// Language is canonicalized first, so that pt_br becomes pt-BR.
+func ParseLang(s string) (lang Lang, err error) {
+	tag, err := language.Parse(s)
+	if err == nil {
+		if _, ok := mapLangToLocalizer[tag.String()]; ok {
+			return Lang(tag.String()), nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedLanguage, s)
+}
+
+// Localizer returns the localizer of the language
+// or the base localizer if the language is empty.
+func (l Lang) Localizer() Localizer {
+	if loc, ok := mapLangToLocalizer[string(l)]; ok {
+		return loc
+	}
+	return Match()
+}
+
+func (l Lang) String() string {
+	return string(l)
+}
+
+// Set implements flag.Value.
+// The language is not changed if the string is not a supported language.
+func (l *Lang) Set(s string) error {
+	lang, err := ParseLang(s)
+	if err != nil {
+		return err
+	}
+
+	*l = lang
+
+	return nil
+}
+
+func (l Lang) MarshalText() ([]byte, error) {
+	return []byte(l), nil
+}
+
+func (l *Lang) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*l = ""
+		return nil
+	}
+	return l.Set(string(text))
+}
+
+func (l Lang) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(l))
+}
+
+// Scan implements sql.Scanner, NULL results in empty language.
+func (l *Lang) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return l.Set(src)
+	case []byte:
+		return l.Set(string(src))
+	case nil:
+		*l = ""
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into Lang", src)
+	}
+}
+
+// Value implements driver.Valuer, empty language results in NULL.
+func (l Lang) Value() (driver.Value, error) {
+	if l == "" {
+		return nil, nil
+	}
+	return string(l), nil
+}
+`
@@ -0,0 +1,606 @@
+package codegen
+
+import (
+	goast "go/ast"
+	goparser "go/parser"
+	gotoken "go/token"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/infastin/l10n-go/ast"
+	"github.com/infastin/l10n-go/common"
+	"github.com/infastin/l10n-go/scope"
+)
+
+// Generates file with the identifiers of the messages
+// and the function localizing messages by their identifiers.
+func generateLocalize(locs []scope.Localization) (file *goast.File) {
+	file = &goast.File{
+		Doc: &goast.CommentGroup{
+			List: []*goast.Comment{
+				{Text: "// Code generated by l10n-go; DO NOT EDIT."},
+				{Text: ""},
+			},
+		},
+		Name:  goast.NewIdent(common.Config.PackageName),
+		Decls: []goast.Decl{},
+	}
+
+	imports := []ast.GoImport{
+		{Import: "errors", Package: "errors"},
+		{Import: "fmt", Package: "fmt"},
+		{Import: "slices", Package: "slices"},
+	}
+
+	numeric := hasNumericArguments(locs[0].Scopes)
+	if numeric {
+		imports = append([]ast.GoImport{{Import: "encoding/json", Package: "json"}}, imports...)
+	}
+
+	for _, imp := range getArgumentImports(locs[0].Scopes) {
+		if !slices.Contains(imports, imp) {
+			imports = append(imports, imp)
+		}
+	}
+
+	importDecl := &goast.GenDecl{
+		Tok: gotoken.IMPORT,
+	}
+
+	for _, imp := range imports {
+		importDecl.Specs = append(importDecl.Specs, &goast.ImportSpec{
+			Path: &goast.BasicLit{
+				Kind:  gotoken.STRING,
+				Value: strconv.Quote(imp.Import),
+			},
+		})
+	}
+
+	file.Decls = append(file.Decls, importDecl)
+
+	generateLocalizeErrors(locs, &file.Decls)
+	generateLocalizeMessageIDs(locs, &file.Decls)
+	generateLocalizeFuncCheckArguments(locs, &file.Decls)
+
+	if numeric {
+		file.Decls = append(file.Decls, parseDecls(numericArgumentsSource)...)
+	}
+
+	generateLocalizeFuncLocalize(locs, &file.Decls)
+
+	return file
+}
+
+func generateLocalizeErrors(_ []scope.Localization, decls *[]goast.Decl) {
+	genDecl := &goast.GenDecl{
+		Tok: gotoken.VAR,
+	}
+
+	errs := [][2]string{
+		{"ErrUnknownMessage", "unknown message"},
+		{"ErrMissingArgument", "missing argument"},
+		{"ErrUnknownArgument", "unknown argument"},
+		{"ErrInvalidArgument", "invalid argument"},
+	}
+
+	for _, err := range errs {
+		genDecl.Specs = append(genDecl.Specs, &goast.ValueSpec{
+			Names: []*goast.Ident{goast.NewIdent(err[0])},
+			Values: []goast.Expr{
+				&goast.CallExpr{
+					Fun: &goast.SelectorExpr{
+						X:   goast.NewIdent("errors"),
+						Sel: goast.NewIdent("New"),
+					},
+					Args: []goast.Expr{
+						&goast.BasicLit{
+							Kind:  gotoken.STRING,
+							Value: strconv.Quote(err[1]),
+						},
+					},
+				},
+			},
+		})
+	}
+
+	*decls = append(*decls, genDecl)
+}
+
+// Generates the type of message identifiers and a constant for each message,
+// which value is the message name qualified with its namespace.
+func generateLocalizeMessageIDs(locs []scope.Localization, decls *[]goast.Decl) {
+	*decls = append(*decls, &goast.GenDecl{
+		Tok: gotoken.TYPE,
+		Specs: []goast.Spec{
+			&goast.TypeSpec{
+				Name: goast.NewIdent("MessageID"),
+				Type: goast.NewIdent("string"),
+			},
+		},
+	})
+
+	constDecl := &goast.GenDecl{
+		Tok: gotoken.CONST,
+	}
+
+	for i := 0; i < len(locs[0].Scopes); i++ {
+		ms := &locs[0].Scopes[i]
+		constDecl.Specs = append(constDecl.Specs, &goast.ValueSpec{
+			Names: []*goast.Ident{goast.NewIdent(getMessageIDName(ms))},
+			Type:  goast.NewIdent("MessageID"),
+			Values: []goast.Expr{
+				&goast.BasicLit{
+					Kind:  gotoken.STRING,
+					Value: strconv.Quote(ms.FullName()),
+				},
+			},
+		})
+	}
+
+	if len(constDecl.Specs) != 0 {
+		*decls = append(*decls, constDecl)
+	}
+}
+
+// Generates function checking that the arguments contain
+// all of the argument names and nothing else.
+func generateLocalizeFuncCheckArguments(_ []scope.Localization, decls *[]goast.Decl) {
+	errorfExpr := func(err string, args ...goast.Expr) goast.Expr {
+		return &goast.CallExpr{
+			Fun: &goast.SelectorExpr{
+				X:   goast.NewIdent("fmt"),
+				Sel: goast.NewIdent("Errorf"),
+			},
+			Args: append([]goast.Expr{
+				&goast.BasicLit{
+					Kind:  gotoken.STRING,
+					Value: strconv.Quote("%w %q of message %q"),
+				},
+				goast.NewIdent(err),
+			}, args...),
+		}
+	}
+
+	*decls = append(*decls, &goast.FuncDecl{
+		Name: goast.NewIdent("checkArguments"),
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{goast.NewIdent("id")},
+						Type:  goast.NewIdent("MessageID"),
+					},
+					{
+						Names: []*goast.Ident{goast.NewIdent("args")},
+						Type: &goast.MapType{
+							Key:   goast.NewIdent("string"),
+							Value: goast.NewIdent("any"),
+						},
+					},
+					{
+						Names: []*goast.Ident{goast.NewIdent("names")},
+						Type: &goast.Ellipsis{
+							Elt: goast.NewIdent("string"),
+						},
+					},
+				},
+			},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{Type: goast.NewIdent("error")},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{
+				&goast.RangeStmt{
+					Key: goast.NewIdent("name"),
+					Tok: gotoken.DEFINE,
+					X:   goast.NewIdent("args"),
+					Body: &goast.BlockStmt{
+						List: []goast.Stmt{
+							&goast.IfStmt{
+								Cond: &goast.UnaryExpr{
+									Op: gotoken.NOT,
+									X: &goast.CallExpr{
+										Fun: &goast.SelectorExpr{
+											X:   goast.NewIdent("slices"),
+											Sel: goast.NewIdent("Contains"),
+										},
+										Args: []goast.Expr{goast.NewIdent("names"), goast.NewIdent("name")},
+									},
+								},
+								Body: &goast.BlockStmt{
+									List: []goast.Stmt{
+										&goast.ReturnStmt{
+											Results: []goast.Expr{
+												errorfExpr("ErrUnknownArgument", goast.NewIdent("name"), goast.NewIdent("id")),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&goast.RangeStmt{
+					Key:   goast.NewIdent("_"),
+					Value: goast.NewIdent("name"),
+					Tok:   gotoken.DEFINE,
+					X:     goast.NewIdent("names"),
+					Body: &goast.BlockStmt{
+						List: []goast.Stmt{
+							&goast.IfStmt{
+								Init: &goast.AssignStmt{
+									Lhs: []goast.Expr{goast.NewIdent("_"), goast.NewIdent("ok")},
+									Tok: gotoken.DEFINE,
+									Rhs: []goast.Expr{
+										&goast.IndexExpr{
+											X:     goast.NewIdent("args"),
+											Index: goast.NewIdent("name"),
+										},
+									},
+								},
+								Cond: &goast.UnaryExpr{
+									Op: gotoken.NOT,
+									X:  goast.NewIdent("ok"),
+								},
+								Body: &goast.BlockStmt{
+									List: []goast.Stmt{
+										&goast.ReturnStmt{
+											Results: []goast.Expr{
+												errorfExpr("ErrMissingArgument", goast.NewIdent("name"), goast.NewIdent("id")),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&goast.ReturnStmt{
+					Results: []goast.Expr{goast.NewIdent("nil")},
+				},
+			},
+		},
+	})
+}
+
+// Generates function localizing the message by its identifier,
+// which checks the arguments and calls the method of the localizer.
+func generateLocalizeFuncLocalize(locs []scope.Localization, decls *[]goast.Decl) {
+	switchStmt := &goast.SwitchStmt{
+		Tag:  goast.NewIdent("id"),
+		Body: &goast.BlockStmt{},
+	}
+
+	for i := 0; i < len(locs[0].Scopes); i++ {
+		generateLocalizeCase(&locs[0].Scopes[i], &switchStmt.Body.List)
+	}
+
+	switchStmt.Body.List = append(switchStmt.Body.List, &goast.CaseClause{
+		Body: []goast.Stmt{
+			&goast.ReturnStmt{
+				Results: []goast.Expr{
+					&goast.BasicLit{
+						Kind:  gotoken.STRING,
+						Value: strconv.Quote(""),
+					},
+					&goast.CallExpr{
+						Fun: &goast.SelectorExpr{
+							X:   goast.NewIdent("fmt"),
+							Sel: goast.NewIdent("Errorf"),
+						},
+						Args: []goast.Expr{
+							&goast.BasicLit{
+								Kind:  gotoken.STRING,
+								Value: strconv.Quote("%w %q"),
+							},
+							goast.NewIdent("ErrUnknownMessage"),
+							goast.NewIdent("id"),
+						},
+					},
+				},
+			},
+		},
+	})
+
+	*decls = append(*decls, &goast.FuncDecl{
+		Name: goast.NewIdent("Localize"),
+		Type: &goast.FuncType{
+			Params: &goast.FieldList{
+				List: []*goast.Field{
+					{
+						Names: []*goast.Ident{goast.NewIdent("loc")},
+						Type:  goast.NewIdent("Localizer"),
+					},
+					{
+						Names: []*goast.Ident{goast.NewIdent("id")},
+						Type:  goast.NewIdent("MessageID"),
+					},
+					{
+						Names: []*goast.Ident{goast.NewIdent("args")},
+						Type: &goast.MapType{
+							Key:   goast.NewIdent("string"),
+							Value: goast.NewIdent("any"),
+						},
+					},
+				},
+			},
+			Results: &goast.FieldList{
+				List: []*goast.Field{
+					{Type: goast.NewIdent("string")},
+					{Type: goast.NewIdent("error")},
+				},
+			},
+		},
+		Body: &goast.BlockStmt{
+			List: []goast.Stmt{switchStmt},
+		},
+	})
+}
+
+// Generates case of the message, where arguments are assigned
+// to the variables named by their index, so that they can't collide
+// with the parameters of the function.
+func generateLocalizeCase(ms *scope.MessageScope, list *[]goast.Stmt) {
+	checkExpr := &goast.CallExpr{
+		Fun:  goast.NewIdent("checkArguments"),
+		Args: []goast.Expr{goast.NewIdent("id"), goast.NewIdent("args")},
+	}
+
+	// Localizer methods are accessed through the namespaces
+	var fun goast.Expr = goast.NewIdent("loc")
+
+	if ms.Namespace != "" {
+		for _, namespace := range strings.Split(ms.Namespace, ast.NamespaceSeparator) {
+			fun = &goast.CallExpr{
+				Fun: &goast.SelectorExpr{
+					X:   fun,
+					Sel: goast.NewIdent(namespace),
+				},
+			}
+		}
+	}
+
+	callExpr := &goast.CallExpr{
+		Fun: &goast.SelectorExpr{
+			X:   fun,
+			Sel: goast.NewIdent(getMessageFuncName(ms)),
+		},
+	}
+
+	caseClause := &goast.CaseClause{
+		List: []goast.Expr{goast.NewIdent(getMessageIDName(ms))},
+		Body: []goast.Stmt{
+			&goast.IfStmt{
+				Init: &goast.AssignStmt{
+					Lhs: []goast.Expr{goast.NewIdent("err")},
+					Tok: gotoken.DEFINE,
+					Rhs: []goast.Expr{checkExpr},
+				},
+				Cond: &goast.BinaryExpr{
+					X:  goast.NewIdent("err"),
+					Op: gotoken.NEQ,
+					Y:  goast.NewIdent("nil"),
+				},
+				Body: &goast.BlockStmt{
+					List: []goast.Stmt{
+						&goast.ReturnStmt{
+							Results: []goast.Expr{
+								&goast.BasicLit{
+									Kind:  gotoken.STRING,
+									Value: strconv.Quote(""),
+								},
+								goast.NewIdent("err"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i := 0; i < len(ms.Arguments); i++ {
+		arg := &ms.Arguments[i]
+		name := "a" + strconv.Itoa(i)
+
+		checkExpr.Args = append(checkExpr.Args, &goast.BasicLit{
+			Kind:  gotoken.STRING,
+			Value: strconv.Quote(arg.Name),
+		})
+		callExpr.Args = append(callExpr.Args, goast.NewIdent(name))
+
+		argExpr := &goast.IndexExpr{
+			X: goast.NewIdent("args"),
+			Index: &goast.BasicLit{
+				Kind:  gotoken.STRING,
+				Value: strconv.Quote(arg.Name),
+			},
+		}
+
+		// Arguments of any type don't need to be checked
+		if arg.GoType.Package == "" && arg.GoType.Type == "any" {
+			caseClause.Body = append(caseClause.Body, &goast.AssignStmt{
+				Lhs: []goast.Expr{goast.NewIdent(name)},
+				Tok: gotoken.DEFINE,
+				Rhs: []goast.Expr{argExpr},
+			})
+			continue
+		}
+
+		typeName := arg.GoType.Type
+		if arg.GoType.Package != "" {
+			typeName = arg.GoType.Package + "." + typeName
+		}
+
+		// Numbers decoded from JSON are float64 or json.Number,
+		// so numeric arguments are converted instead of asserted
+		var valueExpr goast.Expr = &goast.TypeAssertExpr{
+			X:    argExpr,
+			Type: getPackageFieldType(arg),
+		}
+
+		if funcName, ok := numericArgumentFuncs[typeName]; ok {
+			valueExpr = &goast.CallExpr{
+				Fun:  goast.NewIdent(funcName),
+				Args: []goast.Expr{argExpr},
+			}
+		}
+
+		caseClause.Body = append(caseClause.Body,
+			&goast.AssignStmt{
+				Lhs: []goast.Expr{goast.NewIdent(name), goast.NewIdent("ok")},
+				Tok: gotoken.DEFINE,
+				Rhs: []goast.Expr{valueExpr},
+			},
+			&goast.IfStmt{
+				Cond: &goast.UnaryExpr{
+					Op: gotoken.NOT,
+					X:  goast.NewIdent("ok"),
+				},
+				Body: &goast.BlockStmt{
+					List: []goast.Stmt{
+						&goast.ReturnStmt{
+							Results: []goast.Expr{
+								&goast.BasicLit{
+									Kind:  gotoken.STRING,
+									Value: strconv.Quote(""),
+								},
+								&goast.CallExpr{
+									Fun: &goast.SelectorExpr{
+										X:   goast.NewIdent("fmt"),
+										Sel: goast.NewIdent("Errorf"),
+									},
+									Args: []goast.Expr{
+										&goast.BasicLit{
+											Kind:  gotoken.STRING,
+											Value: strconv.Quote("%w %q of message %q: expected " + typeName + ", got %T"),
+										},
+										goast.NewIdent("ErrInvalidArgument"),
+										&goast.BasicLit{
+											Kind:  gotoken.STRING,
+											Value: strconv.Quote(arg.Name),
+										},
+										goast.NewIdent("id"),
+										argExpr,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		)
+	}
+
+	caseClause.Body = append(caseClause.Body, &goast.ReturnStmt{
+		Results: []goast.Expr{callExpr, goast.NewIdent("nil")},
+	})
+
+	*list = append(*list, caseClause)
+}
+
+// Returns name of the constant identifying the message,
+// e.g. MessageIDErrorsNotFound for Errors.NotFound.
+func getMessageIDName(ms *scope.MessageScope) string {
+	return "MessageID" + strings.ReplaceAll(ms.FullName(), ast.NamespaceSeparator, "")
+}
+
+// Functions converting numeric arguments by their types.
+var numericArgumentFuncs = map[string]string{
+	"int":     "intArgument",
+	"float64": "floatArgument",
+}
+
+// Source of the functions converting numeric arguments.
+// Integers accept any integer type and integral floats,
+// and floats accept any numeric type.
+const numericArgumentsSource = `func intArgument(v any) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		if i := int(v); int64(i) == v {
+			return i, true
+		}
+	case uint:
+		if i := int(v); i >= 0 {
+			return i, true
+		}
+	case uint8:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		if i := int(v); i >= 0 {
+			return i, true
+		}
+	case uint64:
+		if i := int(v); i >= 0 && uint64(i) == v {
+			return i, true
+		}
+	case float32:
+		return intArgument(float64(v))
+	case float64:
+		if i := int(v); float64(i) == v {
+			return i, true
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return intArgument(i)
+		}
+		if f, err := v.Float64(); err == nil {
+			return intArgument(f)
+		}
+	}
+	return 0, false
+}
+
+func floatArgument(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f, true
+		}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		if i, ok := intArgument(v); ok {
+			return float64(i), true
+		}
+	}
+	return 0, false
+}
+`
+
+// Reports whether any message has arguments converted by numericArgumentFuncs.
+func hasNumericArguments(mss []scope.MessageScope) bool {
+	for i := 0; i < len(mss); i++ {
+		for j := 0; j < len(mss[i].Arguments); j++ {
+			goType := mss[i].Arguments[j].GoType
+			if _, ok := numericArgumentFuncs[goType.Type]; ok && goType.Package == "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Parses declarations from the source of static code.
+func parseDecls(source string) []goast.Decl {
+	file, err := goparser.ParseFile(gotoken.NewFileSet(), "", "package p\n\n"+source, 0)
+	if err != nil {
+		panic(err)
+	}
+	return file.Decls
+}
@@ -10,44 +10,72 @@ import (
 const cliVersion = "v1.0.6"
 
 var Config struct {
-	Directory         string
+	Directories       []string
+	Recursive         bool
+	Ignore            []string
 	PackageName       string
 	Output            string
 	Pattern           regexp.Regexp
+	FileNamespaces    bool
+	Base              string
+	Fallback          bool
+	HTTP              bool
+	Env               bool
+	Lang              bool
+	Localize          bool
 	FormatSpecifiers  []rune
 	SpecifierToGoType [255]ast.GoType
 	Imports           []ast.GoImport
 }
 
 var cli struct {
-	Dir     string           `required:"" short:"d" type:"existingdir" placeholder:"DIR" help:"Path to the directory with localization files."`
-	Pattern string           `optional:"" short:"p" default:"${pattern}" placeholder:"PATTERN" help:"Localization file regexp pattern."`
-	Package string           `optional:"" short:"P" default:"${package}" help:"Package name."`
-	Output  string           `required:"" short:"o" placeholder:"DIR" help:"Path to output directory."`
-	Version kong.VersionFlag `optional:"" short:"v" help:"Print version number."`
+	Dir        []string         `required:"" short:"d" type:"existingdir" placeholder:"DIR" help:"Path to the directory with localization files. Can be specified multiple times."`
+	Recursive  bool             `optional:"" short:"r" help:"Search for localization files in subdirectories."`
+	Ignore     []string         `optional:"" short:"i" placeholder:"GLOB" help:"Glob patterns of files and directories to ignore."`
+	Pattern    string           `optional:"" short:"p" default:"${pattern}" placeholder:"PATTERN" help:"Localization file regexp pattern."`
+	Package    string           `optional:"" short:"P" default:"${package}" help:"Package name."`
+	Output     string           `required:"" short:"o" placeholder:"DIR" help:"Path to output directory."`
+	Namespaces bool             `optional:"" short:"n" help:"Use names of localization files as namespaces of their messages."`
+	Base       string           `optional:"" short:"b" placeholder:"LANG" help:"Language of the base localization. Defaults to the first found localization without a parent language."`
+	Fallback   bool             `optional:"" short:"f" help:"Fall back to the base localization for missing messages instead of failing."`
+	HTTP       bool             `optional:"" name:"http" help:"Generate context.Context helpers and net/http middleware."`
+	Env        bool             `optional:"" name:"env" help:"Generate function choosing the localizer by locale environment variables."`
+	Lang       bool             `optional:"" name:"lang" help:"Generate type of supported languages."`
+	Localize   bool             `optional:"" name:"localize" help:"Generate message IDs and function localizing messages by them."`
+	Version    kong.VersionFlag `optional:"" short:"v" help:"Print version number."`
 }
 
 func InitConfig() {
 	kong.Parse(&cli,
 		kong.Description("Simple command-line utility to localize your Golang applications."),
 		kong.Vars{
-			"pattern": `([a-z_]+)\.([a-z_]+)\.(yaml|yml|json|toml)`,
+			"pattern": `([a-z_]+)\.([a-zA-Z0-9_-]+)\.(yaml|yml|json|toml)`,
 			"package": "l10n",
 			"version": cliVersion,
 		},
 	)
 
-	Config.Directory = cli.Dir
+	Config.Directories = cli.Dir
+	Config.Recursive = cli.Recursive
+	Config.Ignore = cli.Ignore
 	Config.Pattern = *regexp.MustCompile(cli.Pattern)
 	Config.PackageName = cli.Package
 	Config.Output = cli.Output
+	Config.FileNamespaces = cli.Namespaces
+	Config.Base = cli.Base
+	Config.Fallback = cli.Fallback
+	Config.HTTP = cli.HTTP
+	Config.Env = cli.Env
+	Config.Lang = cli.Lang
+	Config.Localize = cli.Localize
 
-	Config.FormatSpecifiers = []rune{'v', 'd', 'f', 's', 'S'}
+	Config.FormatSpecifiers = []rune{'v', 'd', 'f', 's', 'S', 'b'}
 
 	Config.SpecifierToGoType['v'] = ast.GoType{Type: "any"}
 	Config.SpecifierToGoType['d'] = ast.GoType{Type: "int"}
 	Config.SpecifierToGoType['f'] = ast.GoType{Type: "float64"}
 	Config.SpecifierToGoType['s'] = ast.GoType{Type: "string"}
+	Config.SpecifierToGoType['b'] = ast.GoType{Type: "bool"}
 	Config.SpecifierToGoType['S'] = ast.GoType{
 		Import:  "fmt",
 		Package: "fmt",
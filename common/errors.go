@@ -32,7 +32,6 @@ var (
 	ErrInvalidFilename              = errors.New("invalid filename")
 	ErrInvalidPattern               = errors.New("invalid pattern")
 	ErrInvalidLanguage              = errors.New("invalid language")
-	ErrFilenameDoesNotMatch         = errors.New("filename doesn't match the pattern")
 	ErrCouldNotReadFile             = errors.New("could not read file")
 	ErrUnsupportedFileExtension     = errors.New("unsupported file extension")
 	ErrCouldNotUnmarshalFile        = errors.New("could not unmarshal file")
@@ -42,6 +41,29 @@ var (
 	ErrCouldNotCreateDirectory      = errors.New("could not create directory")
 	ErrCouldNotWriteToFile          = errors.New("could not write to file")
 	ErrNoLocalizationsFound         = errors.New("no localizations found")
+	ErrBaseLocalizationNotFound     = errors.New("base localization not found")
+	ErrInvalidExactValue            = errors.New("invalid exact value")
+	ErrDuplicateExactValue          = errors.New("duplicate exact value")
+	ErrInvalidGoType                = errors.New("invalid go type")
+	ErrCouldNotLoadPackage          = errors.New("could not load package")
+	ErrTypeNotFound                 = errors.New("type not found")
+	ErrUnknownConstant              = errors.New("unknown constant")
+	ErrDuplicateConstantValue       = errors.New("duplicate constant value")
+	ErrConstantNotCovered           = errors.New("constant not covered")
+	ErrInvalidOffset                = errors.New("invalid offset")
+	ErrNumberOutsideOfPlural        = errors.New("number placeholder outside of plural")
+	ErrInvalidRange                 = errors.New("invalid range")
+	ErrEmptyRange                   = errors.New("empty range")
+	ErrUnreachableOther             = errors.New("other is unreachable, ranges cover all values")
+	ErrTermNotSpecified             = errors.New("term not specified")
+	ErrTermFormNotSpecified         = errors.New("term form not specified")
+	ErrTermValueNotSpecified        = errors.New("term value not specified")
+	ErrNoMessageName                = errors.New("no message name")
+	ErrInvalidMessageName           = errors.New("invalid message name")
+	ErrTermReferencesMessage        = errors.New("terms can't reference messages")
+	ErrInvalidNamespaceName         = errors.New("invalid namespace name")
+	ErrMessageConflictsNamespace    = errors.New("message conflicts with namespace")
+	ErrReservedName                 = errors.New("name is reserved")
 )
 
 type ErrorValue struct {
@@ -240,6 +262,27 @@ func (e *MessageNotSpecifiedError) Error() string {
 	return "message \"" + e.Message + "\" not specified"
 }
 
+type ArgumentsMismatchError struct {
+	Message      string
+	Arguments    string
+	Localization string
+	Expected     string
+}
+
+func NewArgumentsMismatchError(message, arguments, localization, expected string) error {
+	return &ArgumentsMismatchError{
+		Message:      message,
+		Arguments:    arguments,
+		Localization: localization,
+		Expected:     expected,
+	}
+}
+
+func (e *ArgumentsMismatchError) Error() string {
+	return "arguments (" + e.Arguments + ") of message \"" + e.Message +
+		"\" don't match arguments (" + e.Expected + ") in localization \"" + e.Localization + "\""
+}
+
 type DuplicateMessageError struct {
 	Message string
 }
@@ -253,3 +296,65 @@ func NewDuplicateMessageError(message string) error {
 func (e *DuplicateMessageError) Error() string {
 	return "duplicate message \"" + e.Message + "\""
 }
+
+type NamespaceConflictError struct {
+	Namespace string
+	Other     string
+}
+
+func NewNamespaceConflictError(namespace, other string) error {
+	return &NamespaceConflictError{
+		Namespace: namespace,
+		Other:     other,
+	}
+}
+
+func (e *NamespaceConflictError) Error() string {
+	return "namespace \"" + e.Namespace + "\" conflicts with namespace \"" + e.Other + "\", since they have the same name without separators"
+}
+
+type MessageConflictError struct {
+	Message string
+	Other   string
+}
+
+func NewMessageConflictError(message, other string) error {
+	return &MessageConflictError{
+		Message: message,
+		Other:   other,
+	}
+}
+
+func (e *MessageConflictError) Error() string {
+	return "message \"" + e.Message + "\" conflicts with message \"" + e.Other + "\", since they have the same name without separators"
+}
+
+type OverlappingRangesError struct {
+	First  string
+	Second string
+}
+
+func NewOverlappingRangesError(first, second string) error {
+	return &OverlappingRangesError{
+		First:  first,
+		Second: second,
+	}
+}
+
+func (e *OverlappingRangesError) Error() string {
+	return "ranges \"" + e.First + "\" and \"" + e.Second + "\" overlap"
+}
+
+type CyclicReferencesError struct {
+	Cycle []string
+}
+
+func NewCyclicReferencesError(cycle []string) error {
+	return &CyclicReferencesError{
+		Cycle: cycle,
+	}
+}
+
+func (e *CyclicReferencesError) Error() string {
+	return "cyclic references: " + strings.Join(e.Cycle, " -> ")
+}
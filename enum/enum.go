@@ -0,0 +1,104 @@
+package enum
+
+import (
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path/filepath"
+
+	"github.com/infastin/l10n-go/ast"
+	"github.com/infastin/l10n-go/common"
+)
+
+type Constant struct {
+	Name  string
+	Value constant.Value
+}
+
+type Enum struct {
+	Package   string
+	Constants []Constant
+}
+
+type enumKey struct {
+	GoType ast.GoType
+	Dir    string
+}
+
+// Set of already loaded enums
+var enums = make(map[enumKey]*Enum)
+
+var fset = token.NewFileSet()
+
+// Importers of the directories, since packages
+// are resolved within the module containing the directory
+var importers = make(map[string]*sourceImporter)
+
+// Loads Go package from local source and finds
+// all the exported constants of the given type.
+// Import path is resolved relative to the directory,
+// which is the directory of the file using the type.
+func Load(goType ast.GoType, dir string) (enum *Enum, err error) {
+	key := enumKey{GoType: goType, Dir: dir}
+	if enum, ok := enums[key]; ok {
+		return enum, nil
+	}
+
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return nil, common.NewError(common.ErrCouldNotLoadPackage,
+			common.ErrorValueStr(goType.Import),
+			common.ErrorWrapped(err),
+		)
+	}
+
+	imp, ok := importers[dir]
+	if !ok {
+		imp = newSourceImporter(fset, dir)
+		importers[dir] = imp
+	}
+
+	pkg, err := imp.ImportFrom(goType.Import, dir, 0)
+	if err != nil {
+		return nil, common.NewError(common.ErrCouldNotLoadPackage,
+			common.ErrorValueStr(goType.Import),
+			common.ErrorWrapped(err),
+		)
+	}
+
+	obj, ok := pkg.Scope().Lookup(goType.Type).(*types.TypeName)
+	if !ok || !obj.Exported() {
+		return nil, common.NewError(common.ErrTypeNotFound,
+			common.ErrorValueStr(goType.Import+"."+goType.Type),
+		)
+	}
+
+	enum = &Enum{
+		Package: pkg.Name(),
+	}
+
+	for _, name := range pkg.Scope().Names() {
+		c, ok := pkg.Scope().Lookup(name).(*types.Const)
+		if !ok || !c.Exported() || !types.Identical(c.Type(), obj.Type()) {
+			continue
+		}
+
+		enum.Constants = append(enum.Constants, Constant{
+			Name:  c.Name(),
+			Value: c.Val(),
+		})
+	}
+
+	enums[key] = enum
+
+	return enum, nil
+}
+
+func (e *Enum) ConstantIndex(name string) (idx int) {
+	for i := 0; i < len(e.Constants); i++ {
+		if e.Constants[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,124 @@
+package enum
+
+import (
+	"errors"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Importer type checking packages from local source.
+// Unlike the source importer of go/importer, it uses its own build context
+// and runs the go command with module downloads disabled,
+// so packages are loaded only from the module cache and vendor directories.
+type sourceImporter struct {
+	ctxt     build.Context
+	fset     *token.FileSet
+	packages map[string]*types.Package
+}
+
+func newSourceImporter(fset *token.FileSet, dir string) *sourceImporter {
+	ctxt := build.Default
+	ctxt.Dir = dir
+	// Files using cgo can't be type checked without running cgo,
+	// so the packages are loaded the same way as with CGO_ENABLED=0
+	ctxt.CgoEnabled = false
+
+	return &sourceImporter{
+		ctxt:     ctxt,
+		fset:     fset,
+		packages: make(map[string]*types.Package),
+	}
+}
+
+func (imp *sourceImporter) Import(path string) (*types.Package, error) {
+	return imp.ImportFrom(path, imp.ctxt.Dir, 0)
+}
+
+func (imp *sourceImporter) ImportFrom(path, dir string, _ types.ImportMode) (*types.Package, error) {
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+
+	bp, err := imp.findPackage(path, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if pkg, ok := imp.packages[bp.ImportPath]; ok {
+		return pkg, nil
+	}
+
+	files := make([]*ast.File, 0, len(bp.GoFiles))
+	for _, name := range bp.GoFiles {
+		file, err := parser.ParseFile(imp.fset, filepath.Join(bp.Dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	var firstHardErr error
+	conf := types.Config{
+		// Only declarations are needed, and imports used only
+		// in function bodies are then reported as soft errors
+		IgnoreFuncBodies: true,
+		Error: func(err error) {
+			if firstHardErr == nil && !err.(types.Error).Soft {
+				firstHardErr = err
+			}
+		},
+		Importer: imp,
+		Sizes:    types.SizesFor(imp.ctxt.Compiler, imp.ctxt.GOARCH),
+	}
+
+	pkg, _ := conf.Check(bp.ImportPath, imp.fset, files, nil)
+	if firstHardErr != nil {
+		return nil, firstHardErr
+	}
+
+	imp.packages[bp.ImportPath] = pkg
+
+	return pkg, nil
+}
+
+// Finds the package imported from the directory.
+// Packages of the standard library and packages imported by them
+// are found by the build context, others by the go command.
+func (imp *sourceImporter) findPackage(path, dir string) (*build.Package, error) {
+	goroot := filepath.Join(imp.ctxt.GOROOT, "src")
+	if strings.HasPrefix(dir, goroot+string(filepath.Separator)) || isDir(filepath.Join(goroot, path)) {
+		return imp.ctxt.Import(path, dir, 0)
+	}
+
+	cmd := exec.Command("go", "list", "-f", "{{.Dir}}", "--", path)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOPROXY=off")
+
+	out, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) != 0 {
+			return nil, errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, err
+	}
+
+	bp, err := imp.ctxt.ImportDir(strings.TrimSpace(string(out)), 0)
+	if err != nil {
+		return nil, err
+	}
+	bp.ImportPath = path
+
+	return bp, nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,10 @@
+package orders
+
+type Status int
+
+const (
+	Pending Status = iota
+	Shipped
+	Delivered
+	Cancelled
+)
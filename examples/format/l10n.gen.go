@@ -2,8 +2,11 @@
 
 package l10n
 
+import "golang.org/x/text/language"
+
 type Localizer interface {
 	BankAccount(money float64) string
+	Tag() language.Tag
 }
 
 var mapLangToLocalizer = map[string]Localizer{
@@ -16,11 +19,38 @@ var Supported = []string{
 	"ru",
 }
 
+var SupportedTags = []language.Tag{
+	en_tag,
+	ru_tag,
+}
+
+var matcher = language.NewMatcher(SupportedTags)
+
 func New(lang string) (loc Localizer, ok bool) {
 	loc, ok = mapLangToLocalizer[lang]
 	return loc, ok
 }
 
+func NewTag(tag language.Tag) (loc Localizer, ok bool) {
+	loc, ok = mapLangToLocalizer[tag.String()]
+	return loc, ok
+}
+
+func MustNew(lang string) Localizer {
+	tag, _ := language.Parse(lang)
+	return Match(tag)
+}
+
+func Match(tags ...language.Tag) Localizer {
+	_, idx, _ := matcher.Match(tags...)
+	return mapLangToLocalizer[Supported[idx]]
+}
+
+func FromAcceptLanguage(header string) Localizer {
+	tags, _, _ := language.ParseAcceptLanguage(header)
+	return Match(tags...)
+}
+
 func Language(loc Localizer) string {
 	switch loc.(type) {
 	case en_Localizer:
@@ -5,6 +5,7 @@ package l10n
 import (
 	"strings"
 	"fmt"
+	"golang.org/x/text/language"
 )
 
 type en_Localizer struct{}
@@ -17,4 +18,10 @@ func (en_l en_Localizer) BankAccount(money float64) string {
 	b0.WriteString(" dollars in your bank account.")
 
 	return b0.String()
+}
+
+var en_tag = language.MustParse("en")
+
+func (en_l en_Localizer) Tag() language.Tag {
+	return en_tag
 }
\ No newline at end of file
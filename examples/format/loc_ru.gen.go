@@ -5,6 +5,7 @@ package l10n
 import (
 	"strings"
 	"fmt"
+	"golang.org/x/text/language"
 )
 
 type ru_Localizer struct{}
@@ -17,4 +18,10 @@ func (ru_l ru_Localizer) BankAccount(money float64) string {
 	b0.WriteString(" рублей.")
 
 	return b0.String()
+}
+
+var ru_tag = language.MustParse("ru")
+
+func (ru_l ru_Localizer) Tag() language.Tag {
+	return ru_tag
 }
\ No newline at end of file
@@ -1,3 +1,3 @@
 package l10n
 
-//go:generate go run github.com/infastin/l10n-go -d loc -o .
+//go:generate go run github.com/infastin/l10n-go -d loc -o . --http --env --lang
@@ -2,7 +2,10 @@
 
 package l10n
 
-import "strings"
+import (
+	"strings"
+	"golang.org/x/text/language"
+)
 
 type en_Localizer struct{}
 
@@ -14,4 +17,10 @@ func (en_l en_Localizer) Hello(name string) string {
 	b0.WriteString("!")
 
 	return b0.String()
+}
+
+var en_tag = language.MustParse("en")
+
+func (en_l en_Localizer) Tag() language.Tag {
+	return en_tag
 }
\ No newline at end of file
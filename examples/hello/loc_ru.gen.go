@@ -2,7 +2,10 @@
 
 package l10n
 
-import "strings"
+import (
+	"strings"
+	"golang.org/x/text/language"
+)
 
 type ru_Localizer struct{}
 
@@ -14,4 +17,10 @@ func (ru_l ru_Localizer) Hello(name string) string {
 	b0.WriteString("!")
 
 	return b0.String()
+}
+
+var ru_tag = language.MustParse("ru")
+
+func (ru_l ru_Localizer) Tag() language.Tag {
+	return ru_tag
 }
\ No newline at end of file
@@ -0,0 +1,38 @@
+package l10n
+
+import (
+	"testing"
+
+	"golang.org/x/text/feature/plural"
+)
+
+func TestCardinalFloat(t *testing.T) {
+	tests := []struct {
+		n    float64
+		prec int
+		en   plural.Form
+		ru   plural.Form
+		fr   plural.Form
+	}{
+		{n: 1, prec: 0, en: plural.One, ru: plural.One, fr: plural.One},
+		{n: 1, prec: 1, en: plural.Other, ru: plural.Other, fr: plural.One},
+		{n: 1.5, prec: 1, en: plural.Other, ru: plural.Other, fr: plural.One},
+		{n: 21, prec: 0, en: plural.Other, ru: plural.One, fr: plural.Other},
+		{n: 1e7 + 0.5, prec: 1, en: plural.Other, ru: plural.Other, fr: plural.Other},
+		{n: -1, prec: 0, en: plural.One, ru: plural.One, fr: plural.One},
+		{n: 1e20 + 1, prec: 0, en: plural.Other, ru: plural.Many, fr: plural.Other},
+		{n: 1.25, prec: 30, en: plural.Other, ru: plural.Other, fr: plural.One},
+	}
+
+	for _, tt := range tests {
+		if got := (en_Localizer{}).cardinalFloat(tt.n, tt.prec); got != tt.en {
+			t.Errorf("en: cardinalFloat(%v, %d) = %v, want %v", tt.n, tt.prec, got, tt.en)
+		}
+		if got := (ru_Localizer{}).cardinalFloat(tt.n, tt.prec); got != tt.ru {
+			t.Errorf("ru: cardinalFloat(%v, %d) = %v, want %v", tt.n, tt.prec, got, tt.ru)
+		}
+		if got := (fr_Localizer{}).cardinalFloat(tt.n, tt.prec); got != tt.fr {
+			t.Errorf("fr: cardinalFloat(%v, %d) = %v, want %v", tt.n, tt.prec, got, tt.fr)
+		}
+	}
+}
@@ -4,14 +4,189 @@ package l10n
 
 import (
 	"strings"
+	"fmt"
+	"golang.org/x/text/feature/plural"
 	"strconv"
+	"golang.org/x/text/language"
+	"math"
 )
 
 type en_Localizer struct{}
 
-func (en_l en_Localizer) YouAreLate_minutes(b0 *strings.Builder, count int)  {
+func (en_l en_Localizer) Crowd(count int) string {
 	switch {
-	case count == 1:
+	case count <= 0:
+		return "Nobody is here."
+	case count >= 1 && count <= 4:
+		return "A few people are here."
+	case count >= 5 && count <= 11:
+		return "Several people are here."
+	case count >= 12 && count <= 99:
+		return "Dozens of people are here."
+	default:
+		return "Hundreds of people are here."
+	}
+}
+
+func (en_l en_Localizer) Duration(hours float64) string {
+	b0 := new(strings.Builder)
+
+	switch en_l.cardinalFloat(hours, 1) {
+	case plural.One:
+		fmt.Fprintf(b0, "%.1f", hours)
+		b0.WriteString(" hour")
+	default:
+		fmt.Fprintf(b0, "%.1f", hours)
+		b0.WriteString(" hours")
+	}
+
+	return b0.String()
+}
+
+func (en_l en_Localizer) Finished(place int) string {
+	b0 := new(strings.Builder)
+
+	switch en_l.ordinal(place) {
+	case plural.One:
+		b0.WriteString("You finished ")
+		b0.WriteString(strconv.Itoa(place))
+		b0.WriteString("st.")
+	case plural.Two:
+		b0.WriteString("You finished ")
+		b0.WriteString(strconv.Itoa(place))
+		b0.WriteString("nd.")
+	case plural.Few:
+		b0.WriteString("You finished ")
+		b0.WriteString(strconv.Itoa(place))
+		b0.WriteString("rd.")
+	default:
+		b0.WriteString("You finished ")
+		b0.WriteString(strconv.Itoa(place))
+		b0.WriteString("th.")
+	}
+
+	return b0.String()
+}
+
+func (en_l en_Localizer) Liked(gender string, name string) string {
+	b0 := new(strings.Builder)
+
+	switch gender {
+	case "female":
+		b0.WriteString(name)
+		b0.WriteString(" liked your post. Say thanks to her!")
+	case "male":
+		b0.WriteString(name)
+		b0.WriteString(" liked your post. Say thanks to him!")
+	default:
+		b0.WriteString(name)
+		b0.WriteString(" liked your post. Say thanks to them!")
+	}
+
+	return b0.String()
+}
+
+func (en_l en_Localizer) LikedBy(count int, name string) string {
+	b0 := new(strings.Builder)
+
+	switch count {
+	case 0:
+		b0.WriteString("Nobody liked your post.")
+	case 1:
+		b0.WriteString(name)
+		b0.WriteString(" liked your post.")
+	default:
+		switch en_l.cardinal(count - 1) {
+		case plural.One:
+			b0.WriteString(name)
+			b0.WriteString(" and ")
+			b0.WriteString(strconv.Itoa(count - 1))
+			b0.WriteString(" other person liked your post.")
+		default:
+			b0.WriteString(name)
+			b0.WriteString(" and ")
+			b0.WriteString(strconv.Itoa(count - 1))
+			b0.WriteString(" other people liked your post.")
+		}
+	}
+
+	return b0.String()
+}
+
+func (en_l en_Localizer) NewMessages(count int) string {
+	b0 := new(strings.Builder)
+
+	switch count {
+	case 0:
+		b0.WriteString("No new messages")
+	default:
+		switch en_l.cardinal(count) {
+		case plural.One:
+			b0.WriteString(strconv.Itoa(count))
+			b0.WriteString(" new message")
+		default:
+			b0.WriteString(strconv.Itoa(count))
+			b0.WriteString(" new messages")
+		}
+	}
+
+	return b0.String()
+}
+
+func (en_l en_Localizer) OrderShipped_express(b0 *strings.Builder, express bool)  {
+	if express {
+		b0.WriteString(" (express)")
+	}
+}
+
+func (en_l en_Localizer) OrderShipped(express bool) string {
+	b0 := new(strings.Builder)
+
+	b0.WriteString("Your order has shipped")
+	en_l.OrderShipped_express(b0, express)
+	b0.WriteString(".")
+
+	return b0.String()
+}
+
+func (en_l en_Localizer) TimeLeft_hours(b0 *strings.Builder, hours int)  {
+	switch en_l.cardinal(hours) {
+	case plural.One:
+		b0.WriteString("1 hour")
+	default:
+		b0.WriteString(strconv.Itoa(hours))
+		b0.WriteString(" hours")
+	}
+}
+
+func (en_l en_Localizer) TimeLeft_minutes(b0 *strings.Builder, minutes int)  {
+	switch en_l.cardinal(minutes) {
+	case plural.One:
+		b0.WriteString("1 minute")
+	default:
+		b0.WriteString(strconv.Itoa(minutes))
+		b0.WriteString(" minutes")
+	}
+}
+
+func (en_l en_Localizer) TimeLeft_duration(b0 *strings.Builder, hours int, minutes int)  {
+	en_l.TimeLeft_hours(b0, hours)
+	b0.WriteString(" and ")
+	en_l.TimeLeft_minutes(b0, minutes)
+}
+
+func (en_l en_Localizer) TimeLeft(hours int, minutes int) string {
+	b0 := new(strings.Builder)
+
+	en_l.TimeLeft_duration(b0, hours, minutes)
+	b0.WriteString(" left.")
+
+	return b0.String()
+}
+
+func (en_l en_Localizer) YouAreLate_minutes(b0 *strings.Builder, count int)  {
+	switch en_l.cardinal(count) {
+	case plural.One:
 		b0.WriteString("1 minute")
 	default:
 		b0.WriteString(strconv.Itoa(count))
@@ -27,4 +202,44 @@ func (en_l en_Localizer) YouAreLate(count int) string {
 	b0.WriteString(" late.")
 
 	return b0.String()
+}
+
+var en_tag = language.MustParse("en")
+
+func (en_l en_Localizer) Tag() language.Tag {
+	return en_tag
+}
+
+func (en_l en_Localizer) cardinalFloat(n float64, prec int) plural.Form {
+	if math.IsInf(n, 0) || math.IsNaN(n) {
+		return plural.Other
+	}
+	i, f, _ := strings.Cut(strconv.FormatFloat(math.Abs(n), 'f', prec, 64), ".")
+	t := strings.TrimRight(f, "0")
+	operand := func(digits string) (v int) {
+		for _, d := range digits {
+			v = v * 10 + int(d - '0')
+
+			if v >= 1e17 {
+				v = v % 1e16 + 1e17
+			}
+		}
+		return v
+	}
+
+	return plural.Cardinal.MatchPlural(en_tag, operand(i), len(f), len(t), operand(f), operand(t))
+}
+
+func (en_l en_Localizer) ordinal(n int) plural.Form {
+	if n < 0 {
+		n = -n
+	}
+	return plural.Ordinal.MatchPlural(en_tag, n, 0, 0, 0, 0)
+}
+
+func (en_l en_Localizer) cardinal(n int) plural.Form {
+	if n < 0 {
+		n = -n
+	}
+	return plural.Cardinal.MatchPlural(en_tag, n, 0, 0, 0, 0)
 }
\ No newline at end of file
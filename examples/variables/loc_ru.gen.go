@@ -5,17 +5,217 @@ package l10n
 import (
 	"strings"
 	"strconv"
+	"fmt"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"math"
 )
 
 type ru_Localizer struct{}
 
-func (ru_l ru_Localizer) YouAreLate_minutes(b0 *strings.Builder, count int)  {
+func (ru_l ru_Localizer) Crowd(count int) string {
+	b0 := new(strings.Builder)
+
 	switch {
-	case count == 1:
-		b0.WriteString("1 минуту")
+	case count >= 1 && count <= 4:
+		b0.WriteString("Здесь несколько человек.")
+	case count >= 12 && count <= 99:
+		b0.WriteString("Здесь десятки человек.")
+	case count >= 100:
+		b0.WriteString("Здесь сотни человек.")
 	default:
+		b0.WriteString("Здесь ")
+		b0.WriteString(strconv.Itoa(count))
+		b0.WriteString(" человек.")
+	}
+
+	return b0.String()
+}
+
+func (ru_l ru_Localizer) Duration(hours float64) string {
+	b0 := new(strings.Builder)
+
+	switch ru_l.cardinalFloat(hours, 1) {
+	case plural.One:
+		fmt.Fprintf(b0, "%.1f", hours)
+		b0.WriteString(" час")
+	case plural.Few:
+		fmt.Fprintf(b0, "%.1f", hours)
+		b0.WriteString(" часа")
+	case plural.Many:
+		fmt.Fprintf(b0, "%.1f", hours)
+		b0.WriteString(" часов")
+	default:
+		fmt.Fprintf(b0, "%.1f", hours)
+		b0.WriteString(" часа")
+	}
+
+	return b0.String()
+}
+
+func (ru_l ru_Localizer) Finished(place int) string {
+	b0 := new(strings.Builder)
+
+	b0.WriteString("Вы финишировали ")
+	b0.WriteString(strconv.Itoa(place))
+	b0.WriteString("-м.")
+
+	return b0.String()
+}
+
+func (ru_l ru_Localizer) Liked(gender string, name string) string {
+	b0 := new(strings.Builder)
+
+	switch gender {
+	case "female":
+		b0.WriteString(name)
+		b0.WriteString(" оценила ваш пост.")
+	case "male":
+		b0.WriteString(name)
+		b0.WriteString(" оценил ваш пост.")
+	default:
+		b0.WriteString(name)
+		b0.WriteString(" оценили ваш пост.")
+	}
+
+	return b0.String()
+}
+
+func (ru_l ru_Localizer) LikedBy(count int, name string) string {
+	b0 := new(strings.Builder)
+
+	switch count {
+	case 0:
+		b0.WriteString("Никто не оценил ваш пост.")
+	case 1:
+		b0.WriteString(name)
+		b0.WriteString(" оценил(а) ваш пост.")
+	default:
+		switch ru_l.cardinal(count - 1) {
+		case plural.One:
+			b0.WriteString(name)
+			b0.WriteString(" и ещё ")
+			b0.WriteString(strconv.Itoa(count - 1))
+			b0.WriteString(" человек оценили ваш пост.")
+		case plural.Few:
+			b0.WriteString(name)
+			b0.WriteString(" и ещё ")
+			b0.WriteString(strconv.Itoa(count - 1))
+			b0.WriteString(" человека оценили ваш пост.")
+		case plural.Many:
+			b0.WriteString(name)
+			b0.WriteString(" и ещё ")
+			b0.WriteString(strconv.Itoa(count - 1))
+			b0.WriteString(" человек оценили ваш пост.")
+		default:
+			b0.WriteString(name)
+			b0.WriteString(" и ещё ")
+			b0.WriteString(strconv.Itoa(count - 1))
+			b0.WriteString(" человека оценили ваш пост.")
+		}
+	}
+
+	return b0.String()
+}
+
+func (ru_l ru_Localizer) NewMessages(count int) string {
+	b0 := new(strings.Builder)
+
+	switch count {
+	case 0:
+		b0.WriteString("Нет новых сообщений")
+	default:
+		switch ru_l.cardinal(count) {
+		case plural.One:
+			b0.WriteString(strconv.Itoa(count))
+			b0.WriteString(" новое сообщение")
+		case plural.Few:
+			b0.WriteString(strconv.Itoa(count))
+			b0.WriteString(" новых сообщения")
+		case plural.Many:
+			b0.WriteString(strconv.Itoa(count))
+			b0.WriteString(" новых сообщений")
+		default:
+			b0.WriteString(strconv.Itoa(count))
+			b0.WriteString(" новых сообщения")
+		}
+	}
+
+	return b0.String()
+}
+
+func (ru_l ru_Localizer) OrderShipped(express bool) string {
+	if express {
+		return "Ваш заказ отправлен экспресс-доставкой."
+	} else {
+		return "Ваш заказ отправлен."
+	}
+}
+
+func (ru_l ru_Localizer) TimeLeft_hours(b0 *strings.Builder, hours int)  {
+	switch ru_l.cardinal(hours) {
+	case plural.One:
+		b0.WriteString(strconv.Itoa(hours))
+		b0.WriteString(" час")
+	case plural.Few:
+		b0.WriteString(strconv.Itoa(hours))
+		b0.WriteString(" часа")
+	case plural.Many:
+		b0.WriteString(strconv.Itoa(hours))
+		b0.WriteString(" часов")
+	default:
+		b0.WriteString(strconv.Itoa(hours))
+		b0.WriteString(" часа")
+	}
+}
+
+func (ru_l ru_Localizer) TimeLeft_minutes(b0 *strings.Builder, minutes int)  {
+	switch ru_l.cardinal(minutes) {
+	case plural.One:
+		b0.WriteString(strconv.Itoa(minutes))
+		b0.WriteString(" минута")
+	case plural.Few:
+		b0.WriteString(strconv.Itoa(minutes))
+		b0.WriteString(" минуты")
+	case plural.Many:
+		b0.WriteString(strconv.Itoa(minutes))
+		b0.WriteString(" минут")
+	default:
+		b0.WriteString(strconv.Itoa(minutes))
+		b0.WriteString(" минуты")
+	}
+}
+
+func (ru_l ru_Localizer) TimeLeft_duration(b0 *strings.Builder, hours int, minutes int)  {
+	ru_l.TimeLeft_hours(b0, hours)
+	b0.WriteString(" и ")
+	ru_l.TimeLeft_minutes(b0, minutes)
+}
+
+func (ru_l ru_Localizer) TimeLeft(hours int, minutes int) string {
+	b0 := new(strings.Builder)
+
+	b0.WriteString("Осталось ")
+	ru_l.TimeLeft_duration(b0, hours, minutes)
+	b0.WriteString(".")
+
+	return b0.String()
+}
+
+func (ru_l ru_Localizer) YouAreLate_minutes(b0 *strings.Builder, count int)  {
+	switch ru_l.cardinal(count) {
+	case plural.One:
+		b0.WriteString(strconv.Itoa(count))
+		b0.WriteString(" минуту")
+	case plural.Few:
+		b0.WriteString(strconv.Itoa(count))
+		b0.WriteString(" минуты")
+	case plural.Many:
 		b0.WriteString(strconv.Itoa(count))
 		b0.WriteString(" минут")
+	default:
+		b0.WriteString(strconv.Itoa(count))
+		b0.WriteString(" минуты")
 	}
 }
 
@@ -27,4 +227,37 @@ func (ru_l ru_Localizer) YouAreLate(count int) string {
 	b0.WriteString(".")
 
 	return b0.String()
+}
+
+var ru_tag = language.MustParse("ru")
+
+func (ru_l ru_Localizer) Tag() language.Tag {
+	return ru_tag
+}
+
+func (ru_l ru_Localizer) cardinalFloat(n float64, prec int) plural.Form {
+	if math.IsInf(n, 0) || math.IsNaN(n) {
+		return plural.Other
+	}
+	i, f, _ := strings.Cut(strconv.FormatFloat(math.Abs(n), 'f', prec, 64), ".")
+	t := strings.TrimRight(f, "0")
+	operand := func(digits string) (v int) {
+		for _, d := range digits {
+			v = v * 10 + int(d - '0')
+
+			if v >= 1e17 {
+				v = v % 1e16 + 1e17
+			}
+		}
+		return v
+	}
+
+	return plural.Cardinal.MatchPlural(ru_tag, operand(i), len(f), len(t), operand(f), operand(t))
+}
+
+func (ru_l ru_Localizer) cardinal(n int) plural.Form {
+	if n < 0 {
+		n = -n
+	}
+	return plural.Cardinal.MatchPlural(ru_tag, n, 0, 0, 0, 0)
 }
\ No newline at end of file
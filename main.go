@@ -1,11 +1,19 @@
 package main
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
-	"go/ast"
+	"io/fs"
 	"os"
 	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/infastin/l10n-go/ast"
 
 	"github.com/infastin/l10n-go/codegen"
 	"github.com/infastin/l10n-go/common"
@@ -15,6 +23,7 @@ import (
 	"github.com/infastin/l10n-go/scope"
 
 	"github.com/BurntSushi/toml"
+	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 	"gopkg.in/yaml.v3"
 )
@@ -28,56 +37,162 @@ type LocalizationFile struct {
 }
 
 func GetLocalizationFiles() (files []LocalizationFile, err error) {
-	entries, err := os.ReadDir(common.Config.Directory)
-	if err != nil {
-		return nil, err
-	}
+	for _, dir := range common.Config.Directories {
+		err = filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
 
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
+			if filePath == dir {
+				return nil
+			}
+
+			// Pattern is matched against the path relative to the directory,
+			// so the name and the language can be taken from subdirectories
+			name, err := filepath.Rel(dir, filePath)
+			if err != nil {
+				return err
+			}
+			name = filepath.ToSlash(name)
+
+			ignored, err := isIgnored(name)
+			if err != nil {
+				return err
+			}
+
+			if entry.IsDir() {
+				if ignored || !common.Config.Recursive {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if ignored {
+				return nil
+			}
+
+			// Directories can contain other files, like READMEs,
+			// so files not matching the pattern are skipped
+			file, ok, err := getLocalizationFile(filePath, name)
+			if err != nil {
+				return err
+			}
+
+			if ok {
+				files = append(files, file)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		name := entry.Name()
+	return files, nil
+}
 
-		matches := common.Config.Pattern.FindStringSubmatch(name)
-		if len(matches) == 0 {
-			return nil, common.NewError(common.ErrInvalidFilename,
-				common.ErrorValueStr(name),
-				common.ErrorWrapped(common.ErrFilenameDoesNotMatch),
-			)
+// Checks whether the file or the directory matches any of the ignore patterns.
+// Patterns are matched against both the relative path and the base name.
+func isIgnored(name string) (ignored bool, err error) {
+	for _, pattern := range common.Config.Ignore {
+		for _, target := range []string{name, path.Base(name)} {
+			ok, err := path.Match(pattern, target)
+			if err != nil {
+				return false, common.NewError(common.ErrInvalidPattern,
+					common.ErrorValueStr(pattern),
+					common.ErrorWrapped(err),
+				)
+			}
+			if ok {
+				return true, nil
+			}
 		}
+	}
+
+	return false, nil
+}
+
+// Matches the relative path of the file against the pattern.
+// Pattern groups can be named "name", "lang" and "ext",
+// otherwise there must be exactly three groups in this order.
+// Returns false if the file doesn't match the pattern.
+func getLocalizationFile(filePath, name string) (file LocalizationFile, ok bool, err error) {
+	pattern := &common.Config.Pattern
+
+	matches := pattern.FindStringSubmatch(name)
+	if len(matches) == 0 {
+		return LocalizationFile{}, false, nil
+	}
 
-		if len(matches) != 4 {
-			return nil, common.NewError(common.ErrInvalidPattern,
-				common.ErrorValueStr(common.Config.Pattern.String()),
+	var fileName, fileLang, fileExt string
+
+	if slices.ContainsFunc(pattern.SubexpNames(), isFileGroupName) {
+		var found [3]bool
+
+		fileName, found[0] = getPatternGroup(pattern, matches, "name")
+		fileLang, found[1] = getPatternGroup(pattern, matches, "lang")
+		fileExt, found[2] = getPatternGroup(pattern, matches, "ext")
+
+		if !found[0] || !found[1] || !found[2] {
+			return LocalizationFile{}, false, common.NewError(common.ErrInvalidPattern,
+				common.ErrorValueStr(pattern.String()),
 			)
 		}
+	} else if len(matches) == 4 {
+		fileName, fileLang, fileExt = matches[1], matches[2], matches[3]
+	} else {
+		return LocalizationFile{}, false, common.NewError(common.ErrInvalidPattern,
+			common.ErrorValueStr(pattern.String()),
+		)
+	}
 
-		lang, err := language.Parse(matches[2])
-		if err != nil {
-			return nil, common.NewError(common.ErrInvalidLanguage,
-				common.ErrorValueStr(matches[1]),
-				common.ErrorWrapped(err),
-			)
+	lang, err := language.Parse(fileLang)
+	if err != nil {
+		return LocalizationFile{}, false, common.NewError(common.ErrInvalidLanguage,
+			common.ErrorValueStr(fileLang),
+			common.ErrorWrapped(err),
+		)
+	}
+
+	return LocalizationFile{
+		Path:     filePath,
+		Filename: name,
+		Name:     fileName,
+		Lang:     lang,
+		Ext:      fileExt,
+	}, true, nil
+}
+
+func isFileGroupName(name string) bool {
+	return name == "name" || name == "lang" || name == "ext"
+}
+
+// Returns the first non-empty submatch of the groups with the given name.
+// Several groups can have the same name, so that the pattern
+// can describe alternative layouts.
+func getPatternGroup(pattern *regexp.Regexp, matches []string, name string) (group string, ok bool) {
+	for i, subexpName := range pattern.SubexpNames() {
+		if subexpName != name {
+			continue
 		}
 
-		files = append(files, LocalizationFile{
-			Path:     path.Join(common.Config.Directory, name),
-			Filename: name,
-			Name:     matches[1],
-			Lang:     lang,
-			Ext:      matches[3],
-		})
+		ok = true
+
+		if matches[i] != "" {
+			return matches[i], true
+		}
 	}
 
-	return files, nil
+	return "", ok
 }
 
 func ReadLocalizationFiles(files []LocalizationFile) (locs []scope.Localization, err error) {
 	// Slice of sets of scope names
 	// Each set corresponds to the localization at the same index
 	var locsScopeNames []map[string]struct{}
+	// Number of files read for each language
+	langFiles := make(map[string]int)
 
 	for i := 0; i < len(files); i++ {
 		file := &files[i]
@@ -103,7 +218,7 @@ func ReadLocalizationFiles(files []LocalizationFile) (locs []scope.Localization,
 			return nil, common.NewError(common.ErrUnsupportedFileExtension, common.ErrorValueStr(file.Ext))
 		}
 
-		msgs, err := parse.UnmarshalMessages(data, unmarshaler)
+		msgs, terms, err := parse.UnmarshalMessages(data, unmarshaler)
 		if err != nil {
 			return nil, common.NewError(common.ErrCouldNotUnmarshalFile,
 				common.ErrorValueStr(file.Filename),
@@ -111,7 +226,29 @@ func ReadLocalizationFiles(files []LocalizationFile) (locs []scope.Localization,
 			)
 		}
 
-		mss, err := process.ProcessMessages(msgs)
+		if common.Config.FileNamespaces {
+			namespace, err := getFileNamespace(file.Name)
+			if err != nil {
+				return nil, common.NewError(common.ErrInvalidFilename,
+					common.ErrorValueStr(file.Filename),
+					common.ErrorWrapped(err),
+				)
+			}
+
+			for i := 0; i < len(msgs); i++ {
+				if msgs[i].Namespace == "" {
+					msgs[i].Namespace = namespace
+				} else {
+					msgs[i].Namespace = ast.JoinNamespace(namespace, msgs[i].Namespace)
+				}
+			}
+		}
+
+		// Files are identified by their order, which doesn't depend on where the generator is run
+		locFile := scope.File{Path: file.Path, Index: langFiles[file.Lang.String()]}
+		langFiles[file.Lang.String()]++
+
+		mss, tss, err := process.ProcessMessages(msgs, terms, locFile)
 		if err != nil {
 			return nil, common.NewError(common.ErrCouldNotParseFile,
 				common.ErrorValueStr(file.Filename),
@@ -127,6 +264,7 @@ func ReadLocalizationFiles(files []LocalizationFile) (locs []scope.Localization,
 				Name:   file.Name,
 				Lang:   file.Lang,
 				Scopes: mss,
+				Terms:  tss,
 			})
 
 			locsScopeNames = append(locsScopeNames, make(map[string]struct{}))
@@ -135,7 +273,7 @@ func ReadLocalizationFiles(files []LocalizationFile) (locs []scope.Localization,
 			// Add scope names to the corresponding set so it is possible
 			// to quickly check for duplicate messages
 			for i := 0; i < len(mss); i++ {
-				locScopeNames[mss[i].Name] = struct{}{}
+				locScopeNames[mss[i].FullName()] = struct{}{}
 			}
 
 			continue
@@ -150,22 +288,181 @@ func ReadLocalizationFiles(files []LocalizationFile) (locs []scope.Localization,
 		for i := 0; i < len(mss); i++ {
 			ms := &mss[i]
 
-			if _, ok := locScopeName[ms.Name]; ok {
+			if _, ok := locScopeName[ms.FullName()]; ok {
 				return nil, common.NewError(common.ErrInvalidLocalization,
 					common.ErrorValueStr(loc.Lang.String()),
-					common.NewDuplicateMessageError(ms.Name),
+					common.NewDuplicateMessageError(ms.FullName()),
 				)
 			}
 
-			locScopeName[ms.Name] = struct{}{}
+			locScopeName[ms.FullName()] = struct{}{}
 		}
 
 		loc.Scopes = append(loc.Scopes, mss...)
+		loc.Terms = append(loc.Terms, tss...)
+	}
+
+	// Base localization goes first, so that it is used
+	// to check other localizations and to generate interfaces
+	if len(locs) != 0 {
+		// Regional variants can be found before their parents, e.g. en-AU before en,
+		// so by default the base localization is the first one without a parent
+		baseIdx := slices.IndexFunc(locs, func(loc scope.Localization) bool {
+			return scope.ParentLocalizationIndex(locs, loc.Lang) == -1
+		})
+
+		if common.Config.Base != "" {
+			lang, err := language.Parse(common.Config.Base)
+			if err != nil {
+				return nil, common.NewError(common.ErrInvalidLanguage,
+					common.ErrorValueStr(common.Config.Base),
+					common.ErrorWrapped(err),
+				)
+			}
+
+			baseIdx = scope.LocalizationIndex(locs, lang)
+			if baseIdx == -1 {
+				return nil, common.NewError(common.ErrBaseLocalizationNotFound, common.ErrorValueStr(lang.String()))
+			}
+		}
+
+		baseLoc := locs[baseIdx]
+		copy(locs[1:baseIdx+1], locs[:baseIdx])
+		locs[0] = baseLoc
+	}
+
+	// Messages can reference messages from other files,
+	// and namespaces can be defined in several files,
+	// so they are processed after all the files are read.
+	// Localizations inherit missing messages from their parents
+	// and fall back to the base localization,
+	// so the parents and the base localization are processed first.
+	for _, loc := range sortLocalizationsByParent(locs) {
+		var delegated []scope.MessageScope
+
+		if loc.Parent != nil {
+			delegated = process.InheritMessages(loc.Scopes, loc.Parent)
+			loc.Scopes = append(loc.Scopes, delegated...)
+		}
+
+		// Base localization and its parents can't fall back to the base localization,
+		// since they are processed before it
+		if common.Config.Fallback && !isBaseOrParent(locs, loc) {
+			fallbacks := process.InheritMessages(loc.Scopes, &locs[0])
+			for i := 0; i < len(fallbacks); i++ {
+				loc.Fallbacks = append(loc.Fallbacks, fallbacks[i].FullName())
+			}
+
+			delegated = append(delegated, fallbacks...)
+			loc.Scopes = append(loc.Scopes, fallbacks...)
+		}
+
+		if len(delegated) != 0 {
+			slices.SortStableFunc(loc.Scopes, func(a, b scope.MessageScope) int {
+				return cmp.Or(
+					strings.Compare(a.Namespace, b.Namespace),
+					strings.Compare(a.Name, b.Name),
+				)
+			})
+		}
+
+		err = process.CheckNamespaces(loc.Scopes)
+		if err != nil {
+			return nil, common.NewError(common.ErrInvalidLocalization,
+				common.ErrorValueStr(loc.Lang.String()),
+				common.ErrorWrapped(err),
+			)
+		}
+
+		err = process.ProcessMessageReferences(loc.Scopes)
+		if err != nil {
+			return nil, common.NewError(common.ErrInvalidLocalization,
+				common.ErrorValueStr(loc.Lang.String()),
+				common.ErrorWrapped(err),
+			)
+		}
 	}
 
 	return locs, nil
 }
 
+// Sets parents of the localizations and returns them
+// in such order that every localization goes after its parent.
+// Base localization and its parents go before the others.
+func sortLocalizationsByParent(locs []scope.Localization) (sorted []*scope.Localization) {
+	depths := make(map[*scope.Localization]int, len(locs))
+
+	for i := 0; i < len(locs); i++ {
+		loc := &locs[i]
+
+		if idx := scope.ParentLocalizationIndex(locs, loc.Lang); idx != -1 {
+			loc.Parent = &locs[idx]
+		}
+
+		sorted = append(sorted, loc)
+	}
+
+	for _, loc := range sorted {
+		for parent := loc.Parent; parent != nil; parent = parent.Parent {
+			depths[loc]++
+		}
+	}
+
+	slices.SortStableFunc(sorted, func(a, b *scope.Localization) int {
+		return cmp.Or(
+			cmpBool(isBaseOrParent(locs, b), isBaseOrParent(locs, a)),
+			depths[a]-depths[b],
+		)
+	})
+
+	return sorted
+}
+
+// Checks whether the localization is the base one or one of its parents.
+func isBaseOrParent(locs []scope.Localization, loc *scope.Localization) bool {
+	for base := &locs[0]; base != nil; base = base.Parent {
+		if base == loc {
+			return true
+		}
+	}
+	return false
+}
+
+func cmpBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// Converts the name of the localization file to the namespace name,
+// e.g. user_emails to UserEmails.
+func getFileNamespace(name string) (namespace string, err error) {
+	var sb strings.Builder
+
+	for _, part := range strings.Split(name, "_") {
+		for i, r := range part {
+			if !unicode.IsLetter(r) {
+				return "", common.NewError(common.ErrInvalidNamespaceName, common.ErrorValueStr(name))
+			}
+			if i == 0 {
+				r = unicode.ToUpper(r)
+			}
+			sb.WriteRune(r)
+		}
+	}
+
+	if sb.Len() == 0 {
+		return "", common.NewError(common.ErrInvalidNamespaceName, common.ErrorValueStr(name))
+	}
+
+	return sb.String(), nil
+}
+
 // Checks whether different localizations contain all the same messages.
 // Also checks if there are any localizations at all.
 func CheckLocalizations(locs []scope.Localization) (err error) {
@@ -173,14 +470,14 @@ func CheckLocalizations(locs []scope.Localization) (err error) {
 		return common.NewError(common.ErrNoLocalizationsFound)
 	}
 
-	// We consider the first localization as the "base" one
+	// Base localization is always the first one
 	baseLoc := &locs[0]
-	// Set of localization messages of the base localization
-	baseMsgs := make(map[string]struct{})
+	// Messages of the base localization by their names
+	baseMsgs := make(map[string]*scope.MessageScope)
 
 	for i := 0; i < len(baseLoc.Scopes); i++ {
 		ms := &locs[0].Scopes[i]
-		baseMsgs[ms.Name] = struct{}{}
+		baseMsgs[ms.FullName()] = ms
 	}
 
 	for i := 1; i < len(locs); i++ {
@@ -193,14 +490,41 @@ func CheckLocalizations(locs []scope.Localization) (err error) {
 		for j := 0; j < len(loc.Scopes); j++ {
 			ms := &loc.Scopes[j]
 
-			if _, ok := baseMsgs[ms.Name]; !ok {
+			baseMs, ok := baseMsgs[ms.FullName()]
+			if !ok {
 				return common.NewError(common.ErrInvalidLocalization,
 					common.ErrorValueStr(baseLoc.Lang.String()),
-					common.NewMessageNotSpecifiedError(ms.Name),
+					common.NewMessageNotSpecifiedError(ms.FullName()),
 				)
 			}
 
-			msgs[ms.Name] = struct{}{}
+			// Methods of all localizations must have the same signatures,
+			// and partial regional localizations are compared with their parents first
+			// to report the localization they override
+			if loc.Parent != nil {
+				if idx := scope.MessageScopeIndex(loc.Parent.Scopes, ms.FullName()); idx != -1 {
+					err = checkArguments(loc, ms, loc.Parent, &loc.Parent.Scopes[idx])
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			err = checkArguments(loc, ms, baseLoc, baseMs)
+			if err != nil {
+				return err
+			}
+
+			// Order of the arguments follows the word order of the translation,
+			// so parameters of the methods take the order of the base localization
+			slices.SortFunc(ms.Arguments, func(a, b scope.Argument) int {
+				return cmp.Compare(
+					scope.ArgumentIndex(baseMs.Arguments, a.Name),
+					scope.ArgumentIndex(baseMs.Arguments, b.Name),
+				)
+			})
+
+			msgs[ms.FullName()] = struct{}{}
 		}
 
 		// Check for unspecified messages in localization
@@ -217,7 +541,55 @@ func CheckLocalizations(locs []scope.Localization) (err error) {
 	return nil
 }
 
-func generateFile(locFile *ast.File, filename string) (err error) {
+// Checks that the message has the same arguments with the same types
+// as the message of the other localization in any order,
+// since they become parameters of the generated methods.
+func checkArguments(
+	loc *scope.Localization,
+	ms *scope.MessageScope,
+	other *scope.Localization,
+	otherMs *scope.MessageScope,
+) (err error) {
+	if len(ms.Arguments) == len(otherMs.Arguments) &&
+		!slices.ContainsFunc(otherMs.Arguments, func(arg scope.Argument) bool {
+			return !slices.Contains(ms.Arguments, arg)
+		}) {
+		return nil
+	}
+
+	return common.NewError(common.ErrInvalidLocalization,
+		common.ErrorValueStr(loc.Lang.String()),
+		common.NewArgumentsMismatchError(
+			ms.FullName(),
+			formatArguments(ms.Arguments),
+			other.Lang.String(),
+			formatArguments(otherMs.Arguments),
+		),
+	)
+}
+
+// Formats the arguments as parameters of the generated method.
+func formatArguments(args []scope.Argument) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(args); i++ {
+		if i != 0 {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString(args[i].Name)
+		sb.WriteByte(' ')
+
+		if args[i].GoType.Package != "" {
+			sb.WriteString(args[i].GoType.Package + ".")
+		}
+		sb.WriteString(args[i].GoType.Type)
+	}
+
+	return sb.String()
+}
+
+func generateFile(locFile *codegen.File, filename string) (err error) {
 	file, err := os.Create(filename)
 	if err != nil {
 		return common.NewError(common.ErrCouldNotCreateFile,
@@ -227,7 +599,11 @@ func generateFile(locFile *ast.File, filename string) (err error) {
 	}
 	defer file.Close()
 
-	err = printer.FprintAstFile(file, locFile)
+	if locFile.Ast != nil {
+		err = printer.FprintAstFile(file, locFile.Ast)
+	} else {
+		_, err = file.WriteString(locFile.Source)
+	}
 	if err != nil {
 		return common.NewError(common.ErrCouldNotWriteToFile,
 			common.ErrorValueStr(filename),
@@ -249,15 +625,8 @@ func GenerateLocalizations(locs []scope.Localization) (err error) {
 		)
 	}
 
-	filenames := []string{path.Join(common.Config.Output, "l10n.gen.go")}
-
-	for i := 1; i < len(locFiles); i++ {
-		filename := locs[i-1].Name + "_" + locs[i-1].Lang.String() + ".gen.go"
-		filenames = append(filenames, path.Join(common.Config.Output, filename))
-	}
-
-	for i, locFile := range locFiles {
-		err = generateFile(locFile, filenames[i])
+	for _, locFile := range locFiles {
+		err = generateFile(&locFile, path.Join(common.Config.Output, locFile.Name+".gen.go"))
 		if err != nil {
 			return err
 		}
@@ -266,6 +635,164 @@ func GenerateLocalizations(locs []scope.Localization) (err error) {
 	return nil
 }
 
+// Prints messages falling back to the base localization.
+func ReportFallbacks(locs []scope.Localization) {
+	for i := 1; i < len(locs); i++ {
+		loc := &locs[i]
+
+		if len(loc.Fallbacks) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "localization %q falls back to %q for %d messages:\n",
+			loc.Lang.String(), locs[0].Lang.String(), len(loc.Fallbacks))
+
+		for _, name := range loc.Fallbacks {
+			fmt.Fprintf(os.Stderr, "\t%s\n", name)
+		}
+	}
+}
+
+type pluralRules struct {
+	Lang language.Tag
+	Func scope.PluralFunc
+}
+
+// Sets of plural categories selected by the plural rules
+var pluralCategories = make(map[pluralRules][]plural.Form)
+
+// Finds the plural categories the plural rules of the language select
+// by matching the numbers covering the conditions of CLDR plural rules:
+// integers up to 1000, powers of ten, and for floating point numbers
+// also the numbers with one or two fractional digits.
+func getPluralCategories(lang language.Tag, fn scope.PluralFunc) []plural.Form {
+	key := pluralRules{Lang: lang, Func: fn}
+	if forms, ok := pluralCategories[key]; ok {
+		return forms
+	}
+
+	rules := plural.Cardinal
+	if fn.Type == ast.PluralOrdinal {
+		rules = plural.Ordinal
+	}
+
+	var forms []plural.Form
+	match := func(i, v, w, f, t int) {
+		form := rules.MatchPlural(lang, i, v, w, f, t)
+		if !slices.Contains(forms, form) {
+			forms = append(forms, form)
+		}
+	}
+
+	for i := 0; i <= 1000; i++ {
+		match(i, 0, 0, 0, 0)
+	}
+	for i := 10000; i <= 1000000000; i *= 10 {
+		match(i, 0, 0, 0, 0)
+	}
+
+	if fn.Float {
+		for i := 0; i <= 200; i++ {
+			for f := 0; f < 10; f++ {
+				match(i, 1, min(f, 1), f, f)
+			}
+			for f := 0; f < 100; f++ {
+				t, w := f, 2
+				for ; w > 0 && t%10 == 0; w-- {
+					t /= 10
+				}
+				match(i, 2, w, f, t)
+			}
+		}
+	}
+
+	pluralCategories[key] = forms
+
+	return forms
+}
+
+// Finds the plural categories defined in the plural,
+// which are never selected by the plural rules of the language.
+func getUnusedPluralCategories(lang language.Tag, p *ast.Plural) (names []string) {
+	if p.IsZero() {
+		return nil
+	}
+
+	forms := getPluralCategories(lang, scope.PluralFunc{Type: p.Type, Float: p.IsFloat()})
+
+	categories := []struct {
+		Name   string
+		Form   plural.Form
+		Format ast.FormatParts
+	}{
+		{"zero", plural.Zero, p.Zero},
+		{"one", plural.One, p.One},
+		{"two", plural.Two, p.Two},
+		{"few", plural.Few, p.Few},
+		{"many", plural.Many, p.Many},
+	}
+
+	for _, c := range categories {
+		if c.Format != nil && !slices.Contains(forms, c.Form) {
+			names = append(names, c.Name)
+		}
+	}
+
+	return names
+}
+
+// Prints plural categories defined in the localizations,
+// which are never selected by the plural rules of their languages,
+// e.g. zero in English, where 0 is selected as other.
+func ReportUnusedPluralCategories(locs []scope.Localization) {
+	for i := 0; i < len(locs); i++ {
+		loc := &locs[i]
+
+		var unused []string
+		report := func(name string, plural, ordinal *ast.Plural) {
+			names := append(getUnusedPluralCategories(loc.Lang, plural),
+				getUnusedPluralCategories(loc.Lang, ordinal)...)
+			if len(names) != 0 {
+				unused = append(unused, name+": "+strings.Join(names, ", "))
+			}
+		}
+
+		for j := 0; j < len(loc.Terms); j++ {
+			term := &loc.Terms[j]
+			report("&{@"+term.Name+"}", &term.Plural, &term.Ordinal)
+			for k := 0; k < len(term.Forms); k++ {
+				form := &term.Forms[k]
+				report("&{@"+term.Name+":"+form.Name+"}", &form.Plural, &form.Ordinal)
+			}
+		}
+
+		for j := 0; j < len(loc.Scopes); j++ {
+			ms := &loc.Scopes[j]
+			// Messages of other localizations are reported with them
+			if ms.Delegate != nil || ms.Inherited != nil {
+				continue
+			}
+
+			report(ms.FullName(), &ms.Plural, &ms.Ordinal)
+			for k := 0; k < len(ms.Variables); k++ {
+				v := &ms.Variables[k]
+				report(ms.FullName()+" &{"+v.Name+"}", &v.Plural, &v.Ordinal)
+			}
+		}
+
+		if len(unused) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "localization %q defines plural categories its plural rules never select:\n",
+			loc.Lang.String())
+
+		for _, name := range unused {
+			fmt.Fprintf(os.Stderr, "\t%s\n", name)
+		}
+	}
+}
+
 func main() {
 	common.InitConfig()
 
@@ -292,4 +819,7 @@ func main() {
 		fmt.Fprintln(os.Stderr, err)
 		return
 	}
+
+	ReportFallbacks(locs)
+	ReportUnusedPluralCategories(locs)
 }
@@ -24,7 +24,7 @@ func parseFormat(fmt string) (parts ast.FormatParts, err error) {
 			break
 		}
 
-		// Preserve '$' or '&' character
+		// Preserve '$', '&' or '@' character
 		text := fmt[:idx+1]
 		fmt = fmt[idx:]
 		cur := rune(fmt[0])
@@ -50,7 +50,7 @@ func parseFormat(fmt string) (parts ast.FormatParts, err error) {
 		fmt = fmt[2:]
 		pos++
 
-		// If encountered '$$' or '&&' write text with '$' or '&'
+		// If encountered '$$', '&&' or '@@' write text with '$', '&' or '@'
 		if cur == next {
 			if text != "" {
 				parts = append(parts, ast.Text(text))
@@ -58,7 +58,7 @@ func parseFormat(fmt string) (parts ast.FormatParts, err error) {
 			continue
 		}
 
-		// If encountered '${' or '&{' write text without '$' and '&'
+		// If encountered '${', '&{' or '@{' write text without '$', '&' and '@'
 		if text := text[:idx]; text != "" {
 			parts = append(parts, ast.Text(text))
 		}
@@ -68,8 +68,18 @@ func parseFormat(fmt string) (parts ast.FormatParts, err error) {
 			return nil, err
 		}
 
-		switch cur {
-		case '$':
+		switch {
+		case cur == '$' && isNumber(fmt[:idx]):
+			number, addPos, err := parseNumber(fmt[:idx])
+			if err != nil {
+				err.(*common.Error).Pos += common.ErrorPosition(pos)
+				return nil, err
+			}
+
+			parts = append(parts, number)
+			pos += addPos
+			fmt = fmt[idx+1:]
+		case cur == '$':
 			arg, addPos, err := parseArgument(fmt[:idx])
 			if err != nil {
 				err.(*common.Error).Pos += common.ErrorPosition(pos)
@@ -79,7 +89,7 @@ func parseFormat(fmt string) (parts ast.FormatParts, err error) {
 			parts = append(parts, arg)
 			pos += addPos
 			fmt = fmt[idx+1:]
-		case '&':
+		case cur == '&':
 			variable, addPos, err := parseVariable(fmt[:idx])
 			if err != nil {
 				err.(*common.Error).Pos += common.ErrorPosition(pos)
@@ -89,6 +99,16 @@ func parseFormat(fmt string) (parts ast.FormatParts, err error) {
 			parts = append(parts, variable)
 			pos += addPos
 			fmt = fmt[idx+1:]
+		case cur == '@':
+			msg, addPos, err := parseMessageReference(fmt[:idx])
+			if err != nil {
+				err.(*common.Error).Pos += common.ErrorPosition(pos)
+				return nil, err
+			}
+
+			parts = append(parts, msg)
+			pos += addPos
+			fmt = fmt[idx+1:]
 		}
 	}
 
@@ -107,7 +127,7 @@ func findBlockStart(fmt string, pos *int) (idx int, err error) {
 			)
 		}
 
-		if r == '$' || r == '&' {
+		if r == '$' || r == '&' || (r == '@' && isMessageReferenceStart(fmt[i:])) {
 			idx = i
 			break
 		}
@@ -147,20 +167,87 @@ func findClosingBracket(fmt string, pos *int) (idx int, err error) {
 	return idx, nil
 }
 
+// Checks whether the text starts with "@{" or its escaped form "@@{",
+// so that '@' characters in other places are treated as text.
+func isMessageReferenceStart(text string) bool {
+	return strings.HasPrefix(text, "@{") || strings.HasPrefix(text, "@@{")
+}
+
+func parseMessageReference(msg string) (info ast.MsgInfo, pos int, err error) {
+	if msg == "" {
+		return ast.MsgInfo{}, 0, common.NewError(common.ErrNoMessageName, common.ErrorPosition(0))
+	}
+
+	// Messages from namespaces are referenced by their full names,
+	// so each part of the name must be an identifier
+	for _, part := range strings.Split(msg, ast.NamespaceSeparator) {
+		if !isMessageNamePart(part) {
+			return ast.MsgInfo{}, 0, common.NewError(common.ErrInvalidMessageName,
+				common.ErrorValueStr(msg),
+				common.ErrorPosition(pos),
+			)
+		}
+		pos += len(part) + len(ast.NamespaceSeparator)
+	}
+
+	info.Name = msg
+
+	return info, len(msg), nil
+}
+
+func isMessageNamePart(part string) bool {
+	if part == "" {
+		return false
+	}
+
+	for i := 0; i < len(part); i++ {
+		c := part[i]
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && c != '_' && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
 func parseVariable(variable string) (info ast.VarInfo, pos int, err error) {
+	// Terms are referenced with '@' prefix
+	// and their forms are separated with ':'
+	if name, ok := strings.CutPrefix(variable, "@"); ok {
+		info.Term = true
+		variable = name
+		pos++
+
+		if name, form, ok := strings.Cut(variable, ":"); ok {
+			switch err = checkVariableName(form); err {
+			case common.ErrInvalidVariableName:
+				return ast.VarInfo{}, 0, common.NewError(err,
+					common.ErrorValueStr(form),
+					common.ErrorPosition(pos+len(name)+1),
+				)
+			case common.ErrNoVariableName:
+				return ast.VarInfo{}, 0, common.NewError(err, common.ErrorPosition(pos+len(name)+1))
+			}
+
+			info.Form = form
+			variable = name
+			pos += len(form) + 1
+		}
+	}
+
 	switch err = checkVariableName(variable); err {
 	case common.ErrInvalidVariableName:
 		return ast.VarInfo{}, 0, common.NewError(err,
 			common.ErrorValueStr(variable),
-			common.ErrorPosition(0),
+			common.ErrorPosition(pos),
 		)
 	case common.ErrNoVariableName:
-		return ast.VarInfo{}, 0, common.NewError(err, common.ErrorPosition(0))
+		return ast.VarInfo{}, 0, common.NewError(err, common.ErrorPosition(pos))
 	}
 
 	info.Name = variable
 
-	return info, len(variable), nil
+	return info, pos + len(variable), nil
 }
 
 func checkVariableName(variable string) (err error) {
@@ -177,6 +264,36 @@ func checkVariableName(variable string) (err error) {
 	return nil
 }
 
+// Checks whether the block is the plural number placeholder.
+func isNumber(arg string) bool {
+	return arg == "#" || strings.HasSuffix(arg, ":#")
+}
+
+func parseNumber(number string) (info ast.NumberInfo, pos int, err error) {
+	colonIdx := strings.IndexByte(number, ':')
+	if colonIdx == -1 {
+		return info, pos, nil
+	}
+
+	formatInfo, addPos, err := parseArgumentFormat(number[:colonIdx])
+	if err != nil {
+		return ast.NumberInfo{}, 0, err
+	}
+
+	if formatInfo.Spec != 0 && formatInfo.Spec != 'd' && formatInfo.Spec != 'f' {
+		return ast.NumberInfo{}, 0, common.NewError(common.ErrInvalidSpecifier,
+			common.ErrorValueChar(formatInfo.Spec),
+			common.ErrorPosition(0),
+			common.ErrorExpectedAnyChar('d', 'f'),
+		)
+	}
+
+	info.FmtInfo = formatInfo
+	pos = addPos
+
+	return info, pos, nil
+}
+
 func parseArgument(arg string) (info ast.ArgInfo, pos int, err error) {
 	colonIdx := strings.IndexByte(arg, ':')
 	if colonIdx != -1 {
@@ -1,23 +1,70 @@
 package parse
 
 import (
+	"cmp"
+	"fmt"
+	"go/token"
+	"path"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/infastin/l10n-go/ast"
 	"github.com/infastin/l10n-go/common"
 )
 
+// Name of the reserved top-level table containing terms.
+const termsTableName = "_terms"
+
 func UnmarshalMessages(in []byte, unmarshaler func(in []byte, out any) (err error),
-) (messages []ast.Message, err error) {
+) (messages []ast.Message, terms []ast.Term, err error) {
 	msgs := make(map[string]any)
 
 	err = unmarshaler(in, &msgs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if msg, ok := msgs[termsTableName]; ok {
+		table, ok := normalizeTable(msg).(map[string]any)
+		if !ok {
+			err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+			return nil, nil, common.NewFieldError(common.ErrCouldNotUnmarshal, termsTableName, err)
+		}
+
+		terms, err = mapTerms(table)
+		if err != nil {
+			return nil, nil, common.NewFieldError(common.ErrCouldNotUnmarshal, termsTableName, err)
+		}
+
+		delete(msgs, termsTableName)
+	}
+
+	messages, err = mapMessages(msgs, "")
+	if err != nil {
+		return nil, nil, err
 	}
 
+	slices.SortStableFunc(messages, func(a, b ast.Message) int {
+		return cmp.Or(
+			strings.Compare(a.Namespace, b.Namespace),
+			strings.Compare(a.Name, b.Name),
+		)
+	})
+
+	slices.SortStableFunc(terms, func(a, b ast.Term) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return messages, terms, nil
+}
+
+// Maps messages of the namespace.
+// Tables that don't contain any of the message fields are mapped as nested namespaces.
+func mapMessages(msgs map[string]any, namespace string) (messages []ast.Message, err error) {
 	for name, msg := range msgs {
+		msg = normalizeTable(msg)
+
 		if str, ok := msg.(string); ok {
 			format, err := parseFormat(str)
 			if err != nil {
@@ -25,8 +72,9 @@ func UnmarshalMessages(in []byte, unmarshaler func(in []byte, out any) (err erro
 			}
 
 			messages = append(messages, ast.Message{
-				Name:   name,
-				String: format,
+				Name:      name,
+				Namespace: namespace,
+				String:    format,
 			})
 
 			continue
@@ -38,6 +86,21 @@ func UnmarshalMessages(in []byte, unmarshaler func(in []byte, out any) (err erro
 			return nil, common.NewFieldError(common.ErrCouldNotUnmarshal, name, err)
 		}
 
+		if !isMessageTable(table) {
+			err = checkNamespaceName(name)
+			if err != nil {
+				return nil, common.NewFieldError(common.ErrCouldNotUnmarshal, name, err)
+			}
+
+			nested, err := mapMessages(table, ast.JoinNamespace(namespace, name))
+			if err != nil {
+				return nil, common.NewFieldError(common.ErrCouldNotUnmarshal, name, err)
+			}
+
+			messages = append(messages, nested...)
+			continue
+		}
+
 		message, err := mapMessage(table)
 		if err != nil {
 			return nil, common.NewFieldError(common.ErrCouldNotUnmarshal, name, err)
@@ -48,16 +111,39 @@ func UnmarshalMessages(in []byte, unmarshaler func(in []byte, out any) (err erro
 		})
 
 		message.Name = name
+		message.Namespace = namespace
 		messages = append(messages, message)
 	}
 
-	slices.SortStableFunc(messages, func(a, b ast.Message) int {
-		return strings.Compare(a.Name, b.Name)
-	})
-
 	return messages, nil
 }
 
+// Checks whether the table is a message rather than a namespace.
+func isMessageTable(table map[string]any) bool {
+	if len(table) == 0 {
+		return true
+	}
+
+	for k := range table {
+		switch k {
+		case "variables", "plural", "ordinal", "select", "when", "range", "string":
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkNamespaceName(namespace string) (err error) {
+	for i := 0; i < len(namespace); i++ {
+		if c := namespace[i]; (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && c != '_' {
+			return common.NewError(common.ErrInvalidNamespaceName, common.ErrorValueStr(namespace))
+		}
+	}
+
+	return nil
+}
+
 func mapMessage(table map[string]any) (message ast.Message, err error) {
 	for k, v := range table {
 		switch k {
@@ -79,7 +165,51 @@ func mapMessage(table map[string]any) (message ast.Message, err error) {
 				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
 			}
 
-			message.Plural, err = mapPlural(v)
+			message.Plural, err = mapPlural(v, ast.PluralCardinal)
+			if err != nil {
+				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+		case "ordinal":
+			v, ok := v.(map[string]any)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			message.Ordinal, err = mapPlural(v, ast.PluralOrdinal)
+			if err != nil {
+				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+		case "select":
+			v, ok := v.(map[string]any)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			message.Select, err = mapSelect(v)
+			if err != nil {
+				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+		case "when":
+			v, ok := v.(map[string]any)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			message.When, err = mapWhen(v)
+			if err != nil {
+				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+		case "range":
+			v, ok := v.(map[string]any)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			message.Range, err = mapRange(v)
 			if err != nil {
 				return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
 			}
@@ -97,7 +227,7 @@ func mapMessage(table map[string]any) (message ast.Message, err error) {
 
 			message.String = format
 		default:
-			err = common.NewError(common.ErrUnknownField, common.ErrorExpectedAnyStr("variables", "plural", "string"))
+			err = common.NewError(common.ErrUnknownField, common.ErrorExpectedAnyStr("variables", "plural", "ordinal", "select", "when", "range", "string"))
 			return ast.Message{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
 		}
 	}
@@ -144,6 +274,92 @@ func mapVariables(table map[string]any) (variables []ast.Variable, err error) {
 	return variables, nil
 }
 
+func mapTerms(table map[string]any) (terms []ast.Term, err error) {
+	for k, v := range table {
+		err = checkVariableName(k)
+		if err != nil {
+			return nil, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		if str, ok := v.(string); ok {
+			format, err := parseFormat(str)
+			if err != nil {
+				return nil, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			terms = append(terms, ast.Term{
+				Variable: ast.Variable{
+					Name:   k,
+					String: format,
+				},
+			})
+
+			continue
+		}
+
+		v, ok := v.(map[string]any)
+		if !ok {
+			err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedAnyStr("string", "table"))
+			return nil, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		term, err := mapTerm(v)
+		if err != nil {
+			return nil, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		term.Name = k
+		terms = append(terms, term)
+	}
+
+	return terms, nil
+}
+
+func mapTerm(table map[string]any) (term ast.Term, err error) {
+	// Term value is mapped as a variable
+	// from the table without term attributes
+	value := make(map[string]any)
+
+	for k, v := range table {
+		switch k {
+		case "forms":
+			v, ok := v.(map[string]any)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+				return ast.Term{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			term.Forms, err = mapVariables(v)
+			if err != nil {
+				return ast.Term{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			slices.SortStableFunc(term.Forms, func(a, b ast.Variable) int {
+				return strings.Compare(a.Name, b.Name)
+			})
+		case "gender":
+			v, ok := v.(string)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("string"))
+				return ast.Term{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			term.Gender = v
+		default:
+			value[k] = v
+		}
+	}
+
+	if len(value) != 0 {
+		term.Variable, err = mapVariable(value)
+		if err != nil {
+			return ast.Term{}, err
+		}
+	}
+
+	return term, nil
+}
+
 func mapVariable(table map[string]any) (variable ast.Variable, err error) {
 	for k, v := range table {
 		switch k {
@@ -154,7 +370,51 @@ func mapVariable(table map[string]any) (variable ast.Variable, err error) {
 				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
 			}
 
-			variable.Plural, err = mapPlural(v)
+			variable.Plural, err = mapPlural(v, ast.PluralCardinal)
+			if err != nil {
+				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+		case "ordinal":
+			v, ok := v.(map[string]any)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			variable.Ordinal, err = mapPlural(v, ast.PluralOrdinal)
+			if err != nil {
+				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+		case "select":
+			v, ok := v.(map[string]any)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			variable.Select, err = mapSelect(v)
+			if err != nil {
+				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+		case "when":
+			v, ok := v.(map[string]any)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			variable.When, err = mapWhen(v)
+			if err != nil {
+				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+		case "range":
+			v, ok := v.(map[string]any)
+			if !ok {
+				err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("table"))
+				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			variable.Range, err = mapRange(v)
 			if err != nil {
 				return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
 			}
@@ -172,7 +432,7 @@ func mapVariable(table map[string]any) (variable ast.Variable, err error) {
 
 			variable.String = format
 		default:
-			err = common.NewError(common.ErrUnknownField, common.ErrorExpectedAnyStr("plural"))
+			err = common.NewError(common.ErrUnknownField, common.ErrorExpectedAnyStr("plural", "ordinal", "select", "when", "range", "string"))
 			return ast.Variable{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
 		}
 	}
@@ -180,8 +440,19 @@ func mapVariable(table map[string]any) (variable ast.Variable, err error) {
 	return variable, nil
 }
 
-func mapPlural(table map[string]any) (plural ast.Plural, err error) {
+func mapPlural(table map[string]any, typ ast.PluralType) (plural ast.Plural, err error) {
+	plural.Type = typ
+
 	for k, v := range table {
+		if k == "offset" {
+			plural.Offset, err = mapOffset(v)
+			if err != nil {
+				return ast.Plural{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			continue
+		}
+
 		v, ok := v.(string)
 		if !ok {
 			err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("string"))
@@ -189,12 +460,18 @@ func mapPlural(table map[string]any) (plural ast.Plural, err error) {
 		}
 
 		if k == "arg" {
-			err = checkArgumentName(v)
+			arg, _, err := parseArgument(v)
+			if err != nil {
+				return ast.Plural{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			err = checkPluralSpecifier(arg.FmtInfo.Spec, typ)
 			if err != nil {
 				return ast.Plural{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
 			}
 
-			plural.Arg = v
+			plural.Arg = arg.Name
+			plural.FmtInfo = arg.FmtInfo
 			continue
 		}
 
@@ -203,20 +480,377 @@ func mapPlural(table map[string]any) (plural ast.Plural, err error) {
 			return ast.Plural{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
 		}
 
+		if strings.HasPrefix(k, "=") {
+			value, err := strconv.Atoi(k[1:])
+			if err != nil {
+				err = common.NewError(common.ErrInvalidExactValue,
+					common.ErrorValueStr(k[1:]),
+					common.ErrorWrapped(err),
+				)
+				return ast.Plural{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			if slices.ContainsFunc(plural.Exact, func(c ast.PluralCase) bool { return c.Value == value }) {
+				err = common.NewError(common.ErrDuplicateExactValue, common.ErrorValueStr(k[1:]))
+				return ast.Plural{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			plural.Exact = append(plural.Exact, ast.PluralCase{
+				Value:  value,
+				Format: format,
+			})
+
+			continue
+		}
+
 		switch k {
 		case "zero":
 			plural.Zero = format
 		case "one":
 			plural.One = format
+		case "two":
+			plural.Two = format
+		case "few":
+			plural.Few = format
 		case "many":
 			plural.Many = format
 		case "other":
 			plural.Other = format
 		default:
-			err = common.NewError(common.ErrUnknownField, common.ErrorExpectedAnyStr("arg", "zero", "one", "many", "other"))
+			err = common.NewError(common.ErrUnknownField, common.ErrorExpectedAnyStr("arg", "offset", "=N", "zero", "one", "two", "few", "many", "other"))
 			return ast.Plural{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
 		}
 	}
 
+	slices.SortFunc(plural.Exact, func(a, b ast.PluralCase) int {
+		return cmp.Compare(a.Value, b.Value)
+	})
+
+	for _, parts := range []ast.FormatParts{plural.Zero, plural.One, plural.Two, plural.Few, plural.Many, plural.Other} {
+		setPluralNumbers(&plural, parts)
+	}
+
+	for i := 0; i < len(plural.Exact); i++ {
+		setPluralNumbers(&plural, plural.Exact[i].Format)
+	}
+
 	return plural, nil
 }
+
+// Checks that the plural argument is integral,
+// or is a floating point number for cardinal plurals.
+func checkPluralSpecifier(spec rune, typ ast.PluralType) (err error) {
+	switch {
+	case spec == 0, spec == 'd':
+		return nil
+	case spec == 'f' && typ == ast.PluralCardinal:
+		return nil
+	case typ == ast.PluralCardinal:
+		return common.NewError(common.ErrInvalidSpecifier,
+			common.ErrorValueChar(spec),
+			common.ErrorExpectedAnyChar('d', 'f'),
+		)
+	default:
+		return common.NewError(common.ErrInvalidSpecifier,
+			common.ErrorValueChar(spec),
+			common.ErrorExpectedChar('d'),
+		)
+	}
+}
+
+// Binds number placeholders to the plural argument and offset.
+// Placeholders without format options inherit the format of the plural argument.
+func setPluralNumbers(plural *ast.Plural, parts ast.FormatParts) {
+	for i, part := range parts {
+		if number, ok := part.(ast.NumberInfo); ok {
+			number.Arg = plural.Arg
+			number.Offset = plural.Offset
+			number.Float = plural.IsFloat()
+
+			if !number.FmtInfo.HasOptions() && number.FmtInfo.Spec == 0 {
+				number.FmtInfo = plural.FmtInfo
+			}
+
+			parts[i] = number
+		}
+	}
+}
+
+func mapOffset(value any) (offset int, err error) {
+	switch v := value.(type) {
+	case int:
+		offset = v
+	case int64:
+		offset = int(v)
+	case float64:
+		offset = int(v)
+		if float64(offset) != v {
+			return 0, common.NewError(common.ErrInvalidOffset, common.ErrorValueStr(fmt.Sprint(v)))
+		}
+	case string:
+		offset, err = strconv.Atoi(v)
+		if err != nil {
+			return 0, common.NewError(common.ErrInvalidOffset, common.ErrorValueStr(v), common.ErrorWrapped(err))
+		}
+	default:
+		return 0, common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedAnyStr("integer", "string"))
+	}
+
+	if offset < 0 {
+		return 0, common.NewError(common.ErrInvalidOffset, common.ErrorValueStr(strconv.Itoa(offset)))
+	}
+
+	return offset, nil
+}
+
+func mapSelect(table map[string]any) (sel ast.Select, err error) {
+	for k, v := range table {
+		v, ok := v.(string)
+		if !ok {
+			err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("string"))
+			return ast.Select{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		if k == "arg" {
+			// Select by the gender of the term
+			if term, ok := strings.CutPrefix(v, "@"); ok {
+				err = checkVariableName(term)
+				if err != nil {
+					return ast.Select{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+				}
+
+				sel.Term = term
+				continue
+			}
+
+			err = checkArgumentName(v)
+			if err != nil {
+				return ast.Select{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			sel.Arg = v
+			continue
+		}
+
+		if k == "type" {
+			sel.Type, err = parseGoType(v)
+			if err != nil {
+				return ast.Select{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			continue
+		}
+
+		format, err := parseFormat(v)
+		if err != nil {
+			return ast.Select{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		if k == "other" {
+			sel.Other = format
+			continue
+		}
+
+		sel.Cases = append(sel.Cases, ast.SelectCase{
+			Key:    k,
+			Format: format,
+		})
+	}
+
+	slices.SortFunc(sel.Cases, func(a, b ast.SelectCase) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return sel, nil
+}
+
+// Parses Go type in the form of "import/path.Type".
+func parseGoType(str string) (goType ast.GoType, err error) {
+	dotIdx := strings.LastIndexByte(str, '.')
+	if dotIdx == -1 || dotIdx < strings.LastIndexByte(str, '/') {
+		return ast.GoType{}, common.NewError(common.ErrInvalidGoType,
+			common.ErrorValueStr(str),
+			common.ErrorExpectedStr("import/path.Type"),
+		)
+	}
+
+	goType.Import = str[:dotIdx]
+	goType.Package = path.Base(goType.Import)
+	goType.Type = str[dotIdx+1:]
+
+	if goType.Import == "" || !token.IsIdentifier(goType.Type) || !token.IsExported(goType.Type) {
+		return ast.GoType{}, common.NewError(common.ErrInvalidGoType,
+			common.ErrorValueStr(str),
+			common.ErrorExpectedStr("import/path.Type"),
+		)
+	}
+
+	return goType, nil
+}
+
+func mapWhen(table map[string]any) (when ast.When, err error) {
+	for k, v := range table {
+		v, ok := v.(string)
+		if !ok {
+			err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("string"))
+			return ast.When{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		if k == "arg" {
+			err = checkArgumentName(v)
+			if err != nil {
+				return ast.When{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			when.Arg = v
+			continue
+		}
+
+		format, err := parseFormat(v)
+		if err != nil {
+			return ast.When{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		switch k {
+		case "true":
+			when.True = format
+		case "false":
+			when.False = format
+		default:
+			err = common.NewError(common.ErrUnknownField, common.ErrorExpectedAnyStr("arg", "true", "false"))
+			return ast.When{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+	}
+
+	return when, nil
+}
+
+func mapRange(table map[string]any) (rng ast.Range, err error) {
+	for k, v := range table {
+		v, ok := v.(string)
+		if !ok {
+			err = common.NewError(common.ErrInvalidFieldType, common.ErrorExpectedStr("string"))
+			return ast.Range{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		if k == "arg" {
+			err = checkArgumentName(v)
+			if err != nil {
+				return ast.Range{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+			}
+
+			rng.Arg = v
+			continue
+		}
+
+		format, err := parseFormat(v)
+		if err != nil {
+			return ast.Range{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		if k == "other" {
+			rng.Other = format
+			continue
+		}
+
+		c, err := parseRange(k)
+		if err != nil {
+			return ast.Range{}, common.NewFieldError(common.ErrCouldNotUnmarshal, k, err)
+		}
+
+		c.Format = format
+		rng.Cases = append(rng.Cases, c)
+	}
+
+	// Sort ranges by their lower bounds,
+	// unbounded ranges go first
+	slices.SortFunc(rng.Cases, func(a, b ast.RangeCase) int {
+		switch {
+		case !a.Min.Valid && !b.Min.Valid:
+			return 0
+		case !a.Min.Valid:
+			return -1
+		case !b.Min.Valid:
+			return 1
+		default:
+			return cmp.Compare(a.Min.Value, b.Min.Value)
+		}
+	})
+
+	return rng, nil
+}
+
+// Parses range in one of the following forms:
+// "N", "A..B", ">N", ">=N", "<N", "<=N".
+func parseRange(key string) (c ast.RangeCase, err error) {
+	c.Key = key
+
+	parseBound := func(str string) (int, error) {
+		value, err := strconv.Atoi(strings.TrimSpace(str))
+		if err != nil {
+			return 0, common.NewError(common.ErrInvalidRange,
+				common.ErrorValueStr(key),
+				common.ErrorExpectedAnyStr("N", "A..B", ">N", ">=N", "<N", "<=N"),
+			)
+		}
+		return value, nil
+	}
+
+	var value int
+
+	switch {
+	case strings.HasPrefix(key, ">="):
+		value, err = parseBound(key[2:])
+		c.Min = ast.BoundOpt{Value: value, Valid: true}
+	case strings.HasPrefix(key, ">"):
+		value, err = parseBound(key[1:])
+		c.Min = ast.BoundOpt{Value: value + 1, Valid: true}
+	case strings.HasPrefix(key, "<="):
+		value, err = parseBound(key[2:])
+		c.Max = ast.BoundOpt{Value: value, Valid: true}
+	case strings.HasPrefix(key, "<"):
+		value, err = parseBound(key[1:])
+		c.Max = ast.BoundOpt{Value: value - 1, Valid: true}
+	default:
+		minStr, maxStr, found := strings.Cut(key, "..")
+		if !found {
+			maxStr = minStr
+		}
+
+		value, err = parseBound(minStr)
+		if err != nil {
+			return ast.RangeCase{}, err
+		}
+
+		c.Min = ast.BoundOpt{Value: value, Valid: true}
+
+		value, err = parseBound(maxStr)
+		c.Max = ast.BoundOpt{Value: value, Valid: true}
+	}
+
+	if err != nil {
+		return ast.RangeCase{}, err
+	}
+
+	return c, nil
+}
+
+// Converts tables with non-string keys, which YAML produces
+// for keys like true or 1, into tables with string keys.
+func normalizeTable(value any) any {
+	switch v := value.(type) {
+	case map[any]any:
+		table := make(map[string]any, len(v))
+		for k, v := range v {
+			table[fmt.Sprint(k)] = normalizeTable(v)
+		}
+		return table
+	case map[string]any:
+		for k, val := range v {
+			v[k] = normalizeTable(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,151 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/infastin/l10n-go/ast"
+)
+
+func TestParseRange(t *testing.T) {
+	bound := func(value int) ast.BoundOpt {
+		return ast.BoundOpt{Value: value, Valid: true}
+	}
+
+	tests := []struct {
+		key string
+		min ast.BoundOpt
+		max ast.BoundOpt
+		err string
+	}{
+		{key: "5", min: bound(5), max: bound(5)},
+		{key: "-3", min: bound(-3), max: bound(-3)},
+		{key: "2..4", min: bound(2), max: bound(4)},
+		{key: " 2 .. 4 ", min: bound(2), max: bound(4)},
+		{key: "4..2", min: bound(4), max: bound(2)},
+		{key: ">=100", min: bound(100)},
+		{key: ">100", min: bound(101)},
+		{key: "<=10", max: bound(10)},
+		{key: "<10", max: bound(9)},
+		{key: "<0", max: bound(-1)},
+		{key: "> 5", min: bound(6)},
+		{
+			key: "",
+			err: `invalid range "", expected any of "N", "A..B", ">N", ">=N", "<N", "<=N"`,
+		},
+		{
+			key: "a",
+			err: `invalid range "a", expected any of "N", "A..B", ">N", ">=N", "<N", "<=N"`,
+		},
+		{
+			key: "2..",
+			err: `invalid range "2..", expected any of "N", "A..B", ">N", ">=N", "<N", "<=N"`,
+		},
+		{
+			key: "..4",
+			err: `invalid range "..4", expected any of "N", "A..B", ">N", ">=N", "<N", "<=N"`,
+		},
+		{
+			key: "2..4..6",
+			err: `invalid range "2..4..6", expected any of "N", "A..B", ">N", ">=N", "<N", "<=N"`,
+		},
+		{
+			key: ">",
+			err: `invalid range ">", expected any of "N", "A..B", ">N", ">=N", "<N", "<=N"`,
+		},
+		{
+			key: "=>5",
+			err: `invalid range "=>5", expected any of "N", "A..B", ">N", ">=N", "<N", "<=N"`,
+		},
+		{
+			key: "<=1.5",
+			err: `invalid range "<=1.5", expected any of "N", "A..B", ">N", ">=N", "<N", "<=N"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			c, err := parseRange(tt.key)
+			if tt.err != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got range %+v", tt.err, c)
+				}
+				if err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %q", tt.err, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.Key != tt.key || c.Min != tt.min || c.Max != tt.max {
+				t.Fatalf("expected range %q [%+v, %+v], got %q [%+v, %+v]",
+					tt.key, tt.min, tt.max, c.Key, c.Min, c.Max)
+			}
+		})
+	}
+}
+
+func TestMapRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		table map[string]any
+		keys  []string
+		err   string
+	}{
+		{
+			name: "sorted by lower bound",
+			table: map[string]any{
+				"arg":    "count",
+				">=100":  "many",
+				"12..99": "dozens",
+				"<2":     "few",
+				"2..4":   "some",
+			},
+			keys: []string{"<2", "2..4", "12..99", ">=100"},
+		},
+		{
+			name: "invalid range",
+			table: map[string]any{
+				"arg":  "count",
+				"2..x": "some",
+			},
+			err: `could not unmarshal 2..x: invalid range "2..x", expected any of "N", "A..B", ">N", ">=N", "<N", "<=N"`,
+		},
+		{
+			name: "invalid field type",
+			table: map[string]any{
+				"arg":  "count",
+				"2..4": 3,
+			},
+			err: `could not unmarshal 2..4: invalid field type, expected "string"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rng, err := mapRange(tt.table)
+			if tt.err != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got range %+v", tt.err, rng)
+				}
+				if err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %q", tt.err, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rng.Cases) != len(tt.keys) {
+				t.Fatalf("expected %d ranges, got %d", len(tt.keys), len(rng.Cases))
+			}
+			for i, key := range tt.keys {
+				if rng.Cases[i].Key != key {
+					t.Fatalf("expected range %q at %d, got %q", key, i, rng.Cases[i].Key)
+				}
+			}
+		})
+	}
+}
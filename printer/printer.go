@@ -175,7 +175,10 @@ func (p *astPrinter) writeField(f *ast.Field) {
 	}
 
 	if f.Type != nil {
-		p.b.WriteByte(' ')
+		// Embedded fields don't have names
+		if len(f.Names) != 0 {
+			p.b.WriteByte(' ')
+		}
 		p.writeExpr(f.Type)
 	}
 }
@@ -204,6 +207,8 @@ func (p *astPrinter) writeExpr(e ast.Expr) {
 		p.writeUnaryExpr(e)
 	case *ast.IndexExpr:
 		p.writeIndexExpr(e)
+	case *ast.SliceExpr:
+		p.writeSliceExpr(e)
 	case *ast.CompositeLit:
 		p.writeComposeLit(e)
 	case *ast.KeyValueExpr:
@@ -214,6 +219,10 @@ func (p *astPrinter) writeExpr(e ast.Expr) {
 		p.writeArrayType(e)
 	case *ast.TypeAssertExpr:
 		p.writeTypeAssertExpr(e)
+	case *ast.Ellipsis:
+		p.writeEllipsis(e)
+	case *ast.FuncLit:
+		p.writeFuncLit(e)
 	}
 }
 
@@ -301,6 +310,10 @@ func (p *astPrinter) writeCallExpr(c *ast.CallExpr) {
 		p.writeExpr(expr)
 	}
 
+	if c.Ellipsis != token.NoPos {
+		p.b.WriteString("...")
+	}
+
 	p.b.WriteByte(')')
 }
 
@@ -324,6 +337,23 @@ func (p *astPrinter) writeIndexExpr(i *ast.IndexExpr) {
 	p.b.WriteByte(']')
 }
 
+func (p *astPrinter) writeSliceExpr(s *ast.SliceExpr) {
+	p.writeExpr(s.X)
+	p.b.WriteByte('[')
+
+	if s.Low != nil {
+		p.writeExpr(s.Low)
+	}
+
+	p.b.WriteByte(':')
+
+	if s.High != nil {
+		p.writeExpr(s.High)
+	}
+
+	p.b.WriteByte(']')
+}
+
 func (p *astPrinter) writeComposeLit(c *ast.CompositeLit) {
 	if c.Type != nil {
 		p.writeExpr(c.Type)
@@ -384,12 +414,31 @@ func (p *astPrinter) writeTypeAssertExpr(t *ast.TypeAssertExpr) {
 	p.b.WriteByte(')')
 }
 
+func (p *astPrinter) writeEllipsis(e *ast.Ellipsis) {
+	p.b.WriteString("...")
+	p.writeExpr(e.Elt)
+}
+
+func (p *astPrinter) writeFuncLit(f *ast.FuncLit) {
+	p.b.WriteString("func")
+	p.writeFuncParams(f.Type.Params)
+
+	if f.Type.Results != nil {
+		p.writeFuncResults(f.Type.Results)
+		p.b.WriteByte(' ')
+	}
+
+	p.writeBlockStmt(f.Body)
+}
+
 func (p *astPrinter) writeStmt(s ast.Stmt) {
 	switch s := s.(type) {
 	case *ast.ExprStmt:
 		p.writeExpr(s.X)
 	case *ast.DeclStmt:
 		p.writeDeclStmt(s)
+	case *ast.IfStmt:
+		p.writeIfStmt(s)
 	case *ast.SwitchStmt:
 		p.writeSwitchStmt(s)
 	case *ast.TypeSwitchStmt:
@@ -400,6 +449,8 @@ func (p *astPrinter) writeStmt(s ast.Stmt) {
 		p.writeAssignStmt(s)
 	case *ast.ReturnStmt:
 		p.writeReturnStmt(s)
+	case *ast.RangeStmt:
+		p.writeRangeStmt(s)
 	}
 }
 
@@ -412,6 +463,28 @@ func (p *astPrinter) writeDeclStmt(s *ast.DeclStmt) {
 	p.writeGenDecl(genDecl)
 }
 
+func (p *astPrinter) writeIfStmt(s *ast.IfStmt) {
+	p.b.WriteString("if ")
+
+	if s.Init != nil {
+		p.writeStmt(s.Init)
+		p.b.WriteString("; ")
+	}
+
+	p.writeExpr(s.Cond)
+	p.b.WriteByte(' ')
+	p.writeBlockStmt(s.Body)
+
+	switch e := s.Else.(type) {
+	case *ast.IfStmt:
+		p.b.WriteString(" else ")
+		p.writeIfStmt(e)
+	case *ast.BlockStmt:
+		p.b.WriteString(" else ")
+		p.writeBlockStmt(e)
+	}
+}
+
 func (p *astPrinter) writeSwitchStmt(s *ast.SwitchStmt) {
 	p.b.WriteString("switch ")
 
@@ -526,6 +599,28 @@ func (p *astPrinter) writeReturnStmt(r *ast.ReturnStmt) {
 	}
 }
 
+func (p *astPrinter) writeRangeStmt(r *ast.RangeStmt) {
+	p.b.WriteString("for ")
+
+	if r.Key != nil {
+		p.writeExpr(r.Key)
+
+		if r.Value != nil {
+			p.b.WriteString(", ")
+			p.writeExpr(r.Value)
+		}
+
+		p.b.WriteByte(' ')
+		p.b.WriteString(r.Tok.String())
+		p.b.WriteByte(' ')
+	}
+
+	p.b.WriteString("range ")
+	p.writeExpr(r.X)
+	p.b.WriteByte(' ')
+	p.writeBlockStmt(r.Body)
+}
+
 func (p *astPrinter) writeBlockStmt(b *ast.BlockStmt) {
 	p.b.WriteString("{\n")
 
@@ -1,10 +1,18 @@
 package process
 
 import (
+	"cmp"
+	"go/constant"
+	gotoken "go/token"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/infastin/l10n-go/ast"
 	"github.com/infastin/l10n-go/common"
+	"github.com/infastin/l10n-go/enum"
 	"github.com/infastin/l10n-go/scope"
 )
 
@@ -13,29 +21,384 @@ type FieldValue struct {
 	Value ast.Value
 }
 
-func ProcessMessages(msgs []ast.Message) (mss []scope.MessageScope, err error) {
+// Processes messages and terms of the file,
+// whose path is used to find the terms they reference and the packages of select types.
+func ProcessMessages(
+	msgs []ast.Message,
+	terms []ast.Term,
+	file scope.File,
+) (mss []scope.MessageScope, tss []scope.TermScope, err error) {
+	tss, err = processTerms(terms, file)
+	if err != nil {
+		return nil, nil, common.NewFieldError(common.ErrCouldNotProcess, "_terms", err)
+	}
+
 	for i := 0; i < len(msgs); i++ {
-		ms, err := processMessage(&msgs[i])
+		ms, err := processMessage(&msgs[i], tss, file)
 		if err != nil {
-			return nil, common.NewFieldError(common.ErrCouldNotProcess, msgs[i].Name, err)
+			return nil, nil, common.NewFieldError(common.ErrCouldNotProcess, ast.JoinNamespace(msgs[i].Namespace, msgs[i].Name), err)
 		}
 
 		mss = append(mss, ms)
 	}
 
-	return mss, nil
+	return mss, tss, nil
+}
+
+// Processes terms in such order that every term
+// is processed after the terms it references.
+func processTerms(terms []ast.Term, file scope.File) (tss []scope.TermScope, err error) {
+	terms, err = sortReferences(terms,
+		func(term *ast.Term) string {
+			return "@" + term.Name
+		},
+		func(term *ast.Term) (names []string) {
+			variables := append([]ast.Variable{term.Variable}, term.Forms...)
+
+			for i := 0; i < len(variables); i++ {
+				variable := &variables[i]
+
+				values := []ast.Value{
+					&variable.Plural,
+					&variable.Ordinal,
+					&variable.Select,
+					&variable.When,
+					&variable.Range,
+					variable.String,
+				}
+
+				for _, val := range values {
+					if !val.IsZero() {
+						for _, name := range val.GetTermNames() {
+							name, _, _ = strings.Cut(name, ":")
+							names = append(names, "@"+name)
+						}
+						break
+					}
+				}
+
+				// Select by the gender of the term also depends on the term
+				if variable.Select.Term != "" {
+					names = append(names, "@"+variable.Select.Term)
+				}
+			}
+
+			return names
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(terms); i++ {
+		ts, err := processTerm(&terms[i], tss, file)
+		if err != nil {
+			return nil, common.NewFieldError(common.ErrCouldNotProcess, terms[i].Name, err)
+		}
+
+		tss = append(tss, ts)
+	}
+
+	return tss, nil
+}
+
+func processTerm(term *ast.Term, terms []scope.TermScope, file scope.File) (ts scope.TermScope, err error) {
+	ts.Name = term.Name
+	ts.File = file
+	ts.Gender = term.Gender
+
+	values := []ast.Value{&term.Plural, &term.Ordinal, &term.Select, &term.When, &term.Range, term.String}
+	hasValue := slices.ContainsFunc(values, func(val ast.Value) bool {
+		return !val.IsZero()
+	})
+
+	// Value can be omitted if the term has forms
+	if hasValue || len(term.Forms) == 0 {
+		msg := getTermMessage(&term.Variable)
+
+		ts.MessageScope, err = processMessage(&msg, terms, file)
+		if err != nil {
+			return scope.TermScope{}, err
+		}
+		setDefaultArgumentTypes(&ts.MessageScope)
+
+		if len(ts.MessageNames) != 0 {
+			return scope.TermScope{}, common.NewFieldError(common.ErrCouldNotProcess, "@{"+ts.MessageNames[0]+"}", common.ErrTermReferencesMessage)
+		}
+	}
+
+	for i := 0; i < len(term.Forms); i++ {
+		form := &term.Forms[i]
+		msg := getTermMessage(form)
+
+		fs, err := processMessage(&msg, terms, file)
+		if err != nil {
+			return scope.TermScope{}, common.NewFieldError(common.ErrCouldNotProcess, "forms."+form.Name, err)
+		}
+		setDefaultArgumentTypes(&fs)
+
+		if len(fs.MessageNames) != 0 {
+			err = common.NewFieldError(common.ErrCouldNotProcess, "@{"+fs.MessageNames[0]+"}", common.ErrTermReferencesMessage)
+			return scope.TermScope{}, common.NewFieldError(common.ErrCouldNotProcess, "forms."+form.Name, err)
+		}
+
+		for _, arg := range fs.Arguments {
+			err = processArg(&ts.MessageScope, arg.Name, arg.GoType)
+			if err != nil {
+				return scope.TermScope{}, common.NewFieldError(common.ErrCouldNotProcess, "forms."+form.Name, err)
+			}
+		}
+
+		ts.Forms = append(ts.Forms, fs)
+	}
+
+	return ts, nil
+}
+
+// Converts the term value or form to a message without variables.
+func getTermMessage(variable *ast.Variable) ast.Message {
+	return ast.Message{
+		Name:    variable.Name,
+		Plural:  variable.Plural,
+		Ordinal: variable.Ordinal,
+		Select:  variable.Select,
+		When:    variable.When,
+		Range:   variable.Range,
+		String:  variable.String,
+	}
+}
+
+// Checks that there are no messages with the same names as namespaces,
+// that there are no root messages or namespaces with the names
+// of the methods reserved by the localizer, and that different namespaces
+// don't result in the same names of generated types, like Errors.Auth and ErrorsAuth.
+func CheckNamespaces(mss []scope.MessageScope) (err error) {
+	namespaces := make(map[string]struct{})
+
+	for i := 0; i < len(mss); i++ {
+		if name := getTopName(&mss[i]); slices.Contains(reservedNames, name) {
+			return common.NewError(common.ErrReservedName, common.ErrorValueStr(name))
+		}
+
+		if mss[i].Namespace == "" {
+			continue
+		}
+
+		// Add namespace with all of its parents
+		parts := strings.Split(mss[i].Namespace, ast.NamespaceSeparator)
+		for j := 1; j <= len(parts); j++ {
+			namespaces[strings.Join(parts[:j], ast.NamespaceSeparator)] = struct{}{}
+		}
+	}
+
+	for i := 0; i < len(mss); i++ {
+		name := mss[i].FullName()
+		if _, ok := namespaces[name]; ok {
+			return common.NewError(common.ErrMessageConflictsNamespace, common.ErrorValueStr(name))
+		}
+	}
+
+	typeNames := make(map[string]string, len(namespaces))
+
+	for _, namespace := range slices.Sorted(maps.Keys(namespaces)) {
+		typeName := strings.ReplaceAll(namespace, ast.NamespaceSeparator, "")
+		if other, ok := typeNames[typeName]; ok {
+			return common.NewNamespaceConflictError(other, namespace)
+		}
+		typeNames[typeName] = namespace
+	}
+
+	// Message identifiers are also generated from the names without separators
+	if common.Config.Localize {
+		idNames := make(map[string]string, len(mss))
+
+		for i := 0; i < len(mss); i++ {
+			name := mss[i].FullName()
+			idName := strings.ReplaceAll(name, ast.NamespaceSeparator, "")
+			if other, ok := idNames[idName]; ok {
+				return common.NewMessageConflictError(other, name)
+			}
+			idNames[idName] = name
+		}
+	}
+
+	return nil
+}
+
+// Names of the methods of the localizer, which can't be used
+// as the names of root messages and namespaces.
+var reservedNames = []string{"Tag"}
+
+// Returns the name of the root message or namespace containing the message.
+func getTopName(ms *scope.MessageScope) string {
+	if ms.Namespace == "" {
+		return ms.Name
+	}
+	top, _, _ := strings.Cut(ms.Namespace, ast.NamespaceSeparator)
+	return top
 }
 
-func processMessage(msg *ast.Message) (ms scope.MessageScope, err error) {
+// Returns messages of the parent localization which are missing in the localization,
+// so that the localization delegates them to the parent.
+// Messages referencing messages of the localization, directly or through other messages,
+// are not delegated, so that the references use the messages of the localization.
+func InheritMessages(mss []scope.MessageScope, parent *scope.Localization) (inherited []scope.MessageScope) {
+	for i := 0; i < len(parent.Scopes); i++ {
+		if scope.MessageScopeIndex(mss, parent.Scopes[i].FullName()) != -1 {
+			continue
+		}
+
+		ms := parent.Scopes[i]
+
+		if referencesMessages(parent.Scopes, &ms, mss) {
+			// Message is defined in the localization it is inherited or delegated from
+			ms.Inherited = cmp.Or(ms.Inherited, ms.Delegate, parent)
+			ms.Delegate = nil
+		} else {
+			ms.Delegate = parent
+			ms.Inherited = nil
+		}
+
+		inherited = append(inherited, ms)
+	}
+
+	return inherited
+}
+
+// Reports whether the message references any of the other messages,
+// following references through the messages of its localization.
+func referencesMessages(mss []scope.MessageScope, ms *scope.MessageScope, others []scope.MessageScope) bool {
+	visited := make(map[string]struct{})
+	names := getMessageNames(ms)
+
+	for len(names) != 0 {
+		name := names[len(names)-1]
+		names = names[:len(names)-1]
+
+		if _, ok := visited[name]; ok {
+			continue
+		}
+		visited[name] = struct{}{}
+
+		if scope.MessageScopeIndex(others, name) != -1 {
+			return true
+		}
+
+		if idx := scope.MessageScopeIndex(mss, name); idx != -1 {
+			names = append(names, getMessageNames(&mss[idx])...)
+		}
+	}
+
+	return false
+}
+
+// Returns names of the messages referenced by the message and its variables.
+func getMessageNames(ms *scope.MessageScope) (names []string) {
+	names = append(names, ms.MessageNames...)
+	for i := 0; i < len(ms.Variables); i++ {
+		names = append(names, ms.Variables[i].MessageNames...)
+	}
+	return names
+}
+
+// Processes references between messages of the same localization.
+// Checks that referenced messages exist and don't reference each other cyclically,
+// and adds arguments of referenced messages to the arguments
+// of the messages and the variables referencing them.
+func ProcessMessageReferences(mss []scope.MessageScope) (err error) {
+	// Inherited messages are already processed in their localizations
+	// and only used to look up referenced messages
+	var ptrs []*scope.MessageScope
+
+	for i := 0; i < len(mss); i++ {
+		ms := &mss[i]
+
+		if ms.Delegate != nil || ms.Inherited != nil {
+			continue
+		}
+
+		ptrs = append(ptrs, ms)
+
+		for _, name := range ms.MessageNames {
+			if scope.MessageScopeIndex(mss, name) == -1 {
+				return common.NewFieldError(common.ErrCouldNotProcess, ms.FullName(), common.NewMessageNotSpecifiedError(name))
+			}
+		}
+	}
+
+	ptrs, err = sortReferences(ptrs,
+		func(ms **scope.MessageScope) string {
+			return (*ms).FullName()
+		},
+		func(ms **scope.MessageScope) []string {
+			return (*ms).MessageNames
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Referenced messages go first, so they already
+	// contain typed arguments of the messages they reference
+	for _, ms := range ptrs {
+		for _, name := range ms.MessageNames {
+			ref := &mss[scope.MessageScopeIndex(mss, name)]
+
+			for _, arg := range ref.Arguments {
+				err = processArg(ms, arg.Name, arg.GoType)
+				if err != nil {
+					err = common.NewFieldError(common.ErrCouldNotProcess, "@{"+name+"}", err)
+					return common.NewFieldError(common.ErrCouldNotProcess, ms.FullName(), err)
+				}
+			}
+		}
+
+		setDefaultArgumentTypes(ms)
+
+		for i := 0; i < len(ms.Variables); i++ {
+			variable := &ms.Variables[i]
+
+			for _, name := range variable.MessageNames {
+				ref := &mss[scope.MessageScopeIndex(mss, name)]
+
+				for _, arg := range ref.Arguments {
+					if !slices.Contains(variable.ArgumentNames, arg.Name) {
+						variable.ArgumentNames = append(variable.ArgumentNames, arg.Name)
+					}
+				}
+			}
+		}
+
+		// Propagate new arguments to the variables referencing other variables
+		err = sortVariables(ms)
+		if err != nil {
+			return common.NewFieldError(common.ErrCouldNotProcess, ms.FullName(), err)
+		}
+	}
+
+	return nil
+}
+
+func processMessage(msg *ast.Message, terms []scope.TermScope, file scope.File) (ms scope.MessageScope, err error) {
 	ms = scope.MessageScope{
-		Name:   msg.Name,
-		Plural: msg.Plural,
-		String: msg.String,
+		Name:      msg.Name,
+		Namespace: msg.Namespace,
+		Plural:    msg.Plural,
+		Ordinal:   msg.Ordinal,
+		Select:    msg.Select,
+		When:      msg.When,
+		Range:     msg.Range,
+		String:    msg.String,
+		File:      file,
 	}
 
 	fields := []FieldValue{
-		{"plural", &msg.Plural},
-		{"string", msg.String},
+		{"plural", &ms.Plural},
+		{"ordinal", &ms.Ordinal},
+		{"select", &ms.Select},
+		{"when", &ms.When},
+		{"range", &ms.Range},
+		{"string", ms.String},
 	}
 
 	err = checkFielsXor(fields)
@@ -44,12 +407,22 @@ func processMessage(msg *ast.Message) (ms scope.MessageScope, err error) {
 	}
 
 	for i := 0; i < len(msg.Variables); i++ {
-		var argNames []string
-		values := []ast.Value{&msg.Variables[i].Plural, msg.Variables[i].String}
+		var argNames, varNames, termNames, msgNames []string
+		values := []ast.Value{
+			&msg.Variables[i].Plural,
+			&msg.Variables[i].Ordinal,
+			&msg.Variables[i].Select,
+			&msg.Variables[i].When,
+			&msg.Variables[i].Range,
+			msg.Variables[i].String,
+		}
 
 		for _, val := range values {
 			if !val.IsZero() {
 				argNames = val.GetArgumentNames()
+				varNames = val.GetVariableNames()
+				termNames = val.GetTermNames()
+				msgNames = val.GetMessageNames()
 				break
 			}
 		}
@@ -57,6 +430,9 @@ func processMessage(msg *ast.Message) (ms scope.MessageScope, err error) {
 		ms.Variables = append(ms.Variables, scope.VariableScope{
 			Variable:      msg.Variables[i],
 			ArgumentNames: argNames,
+			VariableNames: varNames,
+			TermNames:     termNames,
+			MessageNames:  msgNames,
 		})
 	}
 
@@ -72,19 +448,38 @@ func processMessage(msg *ast.Message) (ms scope.MessageScope, err error) {
 		return scope.MessageScope{}, err
 	}
 
+	err = processTermReferences(&ms, terms)
+	if err != nil {
+		return scope.MessageScope{}, err
+	}
+
+	err = sortVariables(&ms)
+	if err != nil {
+		return scope.MessageScope{}, common.NewFieldError(common.ErrCouldNotProcess, "variables", err)
+	}
+
+	return ms, nil
+}
+
+// Sets the type of the arguments, whose type is not specified, to string.
+// Messages get their arguments typed only after adding the arguments
+// of the messages they reference, which can specify the types.
+func setDefaultArgumentTypes(ms *scope.MessageScope) {
 	for i := 0; i < len(ms.Arguments); i++ {
 		arg := &ms.Arguments[i]
 		if arg.GoType.IsZero() {
 			arg.GoType = common.Config.SpecifierToGoType['s']
 		}
 	}
-
-	return ms, nil
 }
 
 func processVariable(ms *scope.MessageScope, variable *scope.VariableScope) (err error) {
 	fields := []FieldValue{
 		{"plural", &variable.Plural},
+		{"ordinal", &variable.Ordinal},
+		{"select", &variable.Select},
+		{"when", &variable.When},
+		{"range", &variable.Range},
 		{"string", variable.String},
 	}
 
@@ -101,24 +496,208 @@ func processVariable(ms *scope.MessageScope, variable *scope.VariableScope) (err
 	return nil
 }
 
+// Adds arguments of the referenced terms to the arguments
+// of the message and the variables referencing them.
+func processTermReferences(ms *scope.MessageScope, terms []scope.TermScope) (err error) {
+	for _, ref := range ms.TermNames {
+		name, form, _ := strings.Cut(ref, ":")
+
+		idx := scope.TermScopeIndex(terms, name)
+		if idx == -1 {
+			return common.NewFieldError(common.ErrCouldNotProcess, "@"+ref, common.ErrTermNotSpecified)
+		}
+
+		term := &terms[idx]
+
+		if form == "" && !term.HasValue() {
+			return common.NewFieldError(common.ErrCouldNotProcess, "@"+ref, common.ErrTermValueNotSpecified)
+		}
+
+		if form != "" && term.FormIndex(form) == -1 {
+			return common.NewFieldError(common.ErrCouldNotProcess, "@"+ref, common.ErrTermFormNotSpecified)
+		}
+
+		for _, arg := range term.Arguments {
+			err = processArg(ms, arg.Name, arg.GoType)
+			if err != nil {
+				return common.NewFieldError(common.ErrCouldNotProcess, "@"+ref, err)
+			}
+		}
+	}
+
+	selects := []*ast.Select{&ms.Select}
+	for i := 0; i < len(ms.Variables); i++ {
+		selects = append(selects, &ms.Variables[i].Select)
+	}
+
+	for _, sel := range selects {
+		if sel.Term != "" && scope.TermScopeIndex(terms, sel.Term) == -1 {
+			return common.NewFieldError(common.ErrCouldNotProcess, "@"+sel.Term, common.ErrTermNotSpecified)
+		}
+	}
+
+	for i := 0; i < len(ms.Variables); i++ {
+		variable := &ms.Variables[i]
+
+		for _, ref := range variable.TermNames {
+			name, _, _ := strings.Cut(ref, ":")
+			idx := scope.TermScopeIndex(terms, name)
+
+			for _, arg := range terms[idx].Arguments {
+				if !slices.Contains(variable.ArgumentNames, arg.Name) {
+					variable.ArgumentNames = append(variable.ArgumentNames, arg.Name)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sorts variables topologically, so that every variable goes after
+// the variables it references, and adds arguments of referenced variables
+// to the arguments of the variable.
+func sortVariables(ms *scope.MessageScope) (err error) {
+	variables, err := sortReferences(ms.Variables,
+		func(variable *scope.VariableScope) string {
+			return variable.Name
+		},
+		func(variable *scope.VariableScope) []string {
+			return variable.VariableNames
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Referenced variables go first, so they already
+	// contain arguments of the variables they reference
+	for i := 0; i < len(variables); i++ {
+		variable := &variables[i]
+
+		for _, name := range variable.VariableNames {
+			idx := scope.VariableScopeIndex(variables, name)
+
+			for _, arg := range variables[idx].ArgumentNames {
+				if !slices.Contains(variable.ArgumentNames, arg) {
+					variable.ArgumentNames = append(variable.ArgumentNames, arg)
+				}
+			}
+		}
+	}
+
+	ms.Variables = variables
+
+	return nil
+}
+
+// Sorts items topologically, so that every item goes after the items it references.
+// References to unknown items are ignored.
+func sortReferences[T any](
+	items []T,
+	getName func(item *T) string,
+	getReferences func(item *T) []string,
+) (sorted []T, err error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	states := make([]int, len(items))
+	sorted = make([]T, 0, len(items))
+	// Stack of names of the items that are being visited
+	var path []string
+
+	indexOf := func(name string) int {
+		for i := 0; i < len(items); i++ {
+			if getName(&items[i]) == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var visit func(idx int) error
+	visit = func(idx int) error {
+		name := getName(&items[idx])
+
+		switch states[idx] {
+		case visited:
+			return nil
+		case visiting:
+			start := slices.Index(path, name)
+			return common.NewCyclicReferencesError(append(path[start:], name))
+		}
+
+		states[idx] = visiting
+		path = append(path, name)
+
+		for _, ref := range getReferences(&items[idx]) {
+			refIdx := indexOf(ref)
+			if refIdx == -1 {
+				continue
+			}
+
+			err := visit(refIdx)
+			if err != nil {
+				return err
+			}
+		}
+
+		path = path[:len(path)-1]
+		states[idx] = visited
+		sorted = append(sorted, items[idx])
+
+		return nil
+	}
+
+	for i := 0; i < len(items); i++ {
+		err = visit(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted, nil
+}
+
 func processPlural(ms *scope.MessageScope, plural *ast.Plural) (err error) {
 	if plural.Arg == "" {
 		return common.NewFieldError(common.ErrCouldNotProcess, "arg", common.ErrFieldNotSpecified)
 	}
 
+	if plural.Other == nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "other", common.ErrFieldNotSpecified)
+	}
+
 	goType := common.Config.SpecifierToGoType['d']
+	if plural.IsFloat() {
+		goType = common.Config.SpecifierToGoType['f']
+	}
 
 	err = processArg(ms, plural.Arg, goType)
 	if err != nil {
 		return common.NewFieldError(common.ErrCouldNotProcess, "arg", err)
 	}
 
+	for i := 0; i < len(plural.Exact); i++ {
+		exact := &plural.Exact[i]
+
+		err = processFormatParts(ms, exact.Format)
+		if err != nil {
+			return common.NewFieldError(common.ErrCouldNotProcess, "="+strconv.Itoa(exact.Value), err)
+		}
+	}
+
 	fields := []struct {
 		Name        string
 		FormatParts ast.FormatParts
 	}{
 		{"zero", plural.Zero},
 		{"one", plural.One},
+		{"two", plural.Two},
+		{"few", plural.Few},
 		{"many", plural.Many},
 		{"other", plural.Other},
 	}
@@ -133,6 +712,242 @@ func processPlural(ms *scope.MessageScope, plural *ast.Plural) (err error) {
 	return nil
 }
 
+func processSelect(ms *scope.MessageScope, sel *ast.Select) (err error) {
+	if sel.Arg == "" && sel.Term == "" {
+		return common.NewFieldError(common.ErrCouldNotProcess, "arg", common.ErrFieldNotSpecified)
+	}
+
+	if sel.Term != "" {
+		return processSelectTerm(ms, sel)
+	}
+
+	goType := common.Config.SpecifierToGoType['s']
+
+	if !sel.Type.IsZero() {
+		err = processSelectType(sel, filepath.Dir(ms.File.Path))
+		if err != nil {
+			return err
+		}
+
+		goType = sel.Type
+	} else if sel.Other == nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "other", common.ErrFieldNotSpecified)
+	}
+
+	err = processArg(ms, sel.Arg, goType)
+	if err != nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "arg", err)
+	}
+
+	for i := 0; i < len(sel.Cases); i++ {
+		c := &sel.Cases[i]
+
+		err = processFormatParts(ms, c.Format)
+		if err != nil {
+			return common.NewFieldError(common.ErrCouldNotProcess, c.Key, err)
+		}
+	}
+
+	err = processFormatParts(ms, sel.Other)
+	if err != nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "other", err)
+	}
+
+	return nil
+}
+
+// Processes select by the gender of the term,
+// which is resolved at generation time.
+func processSelectTerm(ms *scope.MessageScope, sel *ast.Select) (err error) {
+	if !sel.Type.IsZero() {
+		return common.NewFieldError(common.ErrCouldNotProcess, "[arg,type]", common.ErrFieldsSpecifiedAtTheSameTime)
+	}
+
+	if sel.Other == nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "other", common.ErrFieldNotSpecified)
+	}
+
+	for i := 0; i < len(sel.Cases); i++ {
+		c := &sel.Cases[i]
+
+		err = processFormatParts(ms, c.Format)
+		if err != nil {
+			return common.NewFieldError(common.ErrCouldNotProcess, c.Key, err)
+		}
+	}
+
+	err = processFormatParts(ms, sel.Other)
+	if err != nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "other", err)
+	}
+
+	return nil
+}
+
+// Checks that select keys are constants of the select type,
+// and, if "other" is not specified, that all the constants are covered.
+// Package of the type is resolved relative to the directory of the file.
+func processSelectType(sel *ast.Select, dir string) (err error) {
+	e, err := enum.Load(sel.Type, dir)
+	if err != nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "type", err)
+	}
+
+	sel.Type.Package = e.Package
+
+	// Indices of constants covered by select keys
+	var covered []int
+
+	for i := 0; i < len(sel.Cases); i++ {
+		c := &sel.Cases[i]
+
+		idx := e.ConstantIndex(c.Key)
+		if idx == -1 {
+			err = common.NewError(common.ErrUnknownConstant, common.ErrorValueStr(c.Key))
+			return common.NewFieldError(common.ErrCouldNotProcess, c.Key, err)
+		}
+
+		for _, otherIdx := range covered {
+			if constant.Compare(e.Constants[idx].Value, gotoken.EQL, e.Constants[otherIdx].Value) {
+				err = common.NewError(common.ErrDuplicateConstantValue, common.ErrorValueStr(e.Constants[otherIdx].Name))
+				return common.NewFieldError(common.ErrCouldNotProcess, c.Key, err)
+			}
+		}
+
+		covered = append(covered, idx)
+	}
+
+	if sel.Other != nil {
+		return nil
+	}
+
+	for i := 0; i < len(e.Constants); i++ {
+		isCovered := slices.ContainsFunc(covered, func(idx int) bool {
+			return constant.Compare(e.Constants[i].Value, gotoken.EQL, e.Constants[idx].Value)
+		})
+
+		if !isCovered {
+			err = common.NewError(common.ErrConstantNotCovered, common.ErrorValueStr(e.Constants[i].Name))
+			return common.NewFieldError(common.ErrCouldNotProcess, "other", err)
+		}
+	}
+
+	return nil
+}
+
+func processWhen(ms *scope.MessageScope, when *ast.When) (err error) {
+	if when.Arg == "" {
+		return common.NewFieldError(common.ErrCouldNotProcess, "arg", common.ErrFieldNotSpecified)
+	}
+
+	if when.True == nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "true", common.ErrFieldNotSpecified)
+	}
+
+	goType := common.Config.SpecifierToGoType['b']
+
+	err = processArg(ms, when.Arg, goType)
+	if err != nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "arg", err)
+	}
+
+	fields := []struct {
+		Name        string
+		FormatParts ast.FormatParts
+	}{
+		{"true", when.True},
+		{"false", when.False},
+	}
+
+	for _, field := range fields {
+		err = processFormatParts(ms, field.FormatParts)
+		if err != nil {
+			return common.NewFieldError(common.ErrCouldNotProcess, field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func processRange(ms *scope.MessageScope, rng *ast.Range) (err error) {
+	if rng.Arg == "" {
+		return common.NewFieldError(common.ErrCouldNotProcess, "arg", common.ErrFieldNotSpecified)
+	}
+
+	goType := common.Config.SpecifierToGoType['d']
+
+	err = processArg(ms, rng.Arg, goType)
+	if err != nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "arg", err)
+	}
+
+	err = checkRanges(rng)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(rng.Cases); i++ {
+		c := &rng.Cases[i]
+
+		err = processFormatParts(ms, c.Format)
+		if err != nil {
+			return common.NewFieldError(common.ErrCouldNotProcess, c.Key, err)
+		}
+	}
+
+	err = processFormatParts(ms, rng.Other)
+	if err != nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "other", err)
+	}
+
+	return nil
+}
+
+// Checks that ranges, which are expected to be sorted by their lower bounds,
+// are not empty and don't overlap, and that "other" is specified
+// only when it is reachable.
+func checkRanges(rng *ast.Range) (err error) {
+	// Whether the ranges cover all the values
+	// from the minimum one to the upper bound of the previous range
+	covered := len(rng.Cases) != 0 && !rng.Cases[0].Min.Valid
+
+	for i := 0; i < len(rng.Cases); i++ {
+		c := &rng.Cases[i]
+
+		if c.Min.Valid && c.Max.Valid && c.Min.Value > c.Max.Value {
+			err = common.NewError(common.ErrEmptyRange, common.ErrorValueStr(c.Key))
+			return common.NewFieldError(common.ErrCouldNotProcess, c.Key, err)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		prev := &rng.Cases[i-1]
+
+		if !prev.Max.Valid || !c.Min.Valid || prev.Max.Value >= c.Min.Value {
+			err = common.NewOverlappingRangesError(prev.Key, c.Key)
+			return common.NewFieldError(common.ErrCouldNotProcess, c.Key, err)
+		}
+
+		if prev.Max.Value+1 != c.Min.Value {
+			covered = false
+		}
+	}
+
+	covered = covered && !rng.Cases[len(rng.Cases)-1].Max.Valid
+
+	if covered && rng.Other != nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "other", common.ErrUnreachableOther)
+	}
+
+	if !covered && rng.Other == nil {
+		return common.NewFieldError(common.ErrCouldNotProcess, "other", common.ErrFieldNotSpecified)
+	}
+
+	return nil
+}
+
 func processFormatParts(ms *scope.MessageScope, parts ast.FormatParts) (err error) {
 	for _, cell := range parts {
 		switch cell := cell.(type) {
@@ -147,10 +962,35 @@ func processFormatParts(ms *scope.MessageScope, parts ast.FormatParts) (err erro
 				return err
 			}
 		case ast.VarInfo:
+			if cell.Term {
+				name := cell.Name
+				if cell.Form != "" {
+					name += ":" + cell.Form
+				}
+
+				if !slices.Contains(ms.TermNames, name) {
+					ms.TermNames = append(ms.TermNames, name)
+				}
+
+				continue
+			}
+
 			idx := scope.VariableScopeIndex(ms.Variables, cell.Name)
 			if idx == -1 {
 				return common.NewFieldError(common.ErrCouldNotProcess, cell.Name, common.ErrVariableNotSpecified)
 			}
+		case ast.MsgInfo:
+			if !slices.Contains(ms.MessageNames, cell.Name) {
+				ms.MessageNames = append(ms.MessageNames, cell.Name)
+			}
+		case ast.NumberInfo:
+			if cell.Arg == "" {
+				return common.NewFieldError(common.ErrCouldNotProcess, "#", common.ErrNumberOutsideOfPlural)
+			}
+
+			if spec := cell.FmtInfo.Spec; spec != 0 && (spec == 'f') != cell.Float {
+				return common.NewFieldError(common.ErrCouldNotProcess, "#", common.ErrTypesDontMatch)
+			}
 		}
 	}
 
@@ -236,6 +1076,12 @@ func processFields(ms *scope.MessageScope, fields []FieldValue) (err error) {
 		switch v := field.Value.(type) {
 		case *ast.Plural:
 			err = processPlural(ms, v)
+		case *ast.Select:
+			err = processSelect(ms, v)
+		case *ast.When:
+			err = processWhen(ms, v)
+		case *ast.Range:
+			err = processRange(ms, v)
 		case ast.FormatParts:
 			err = processFormatParts(ms, v)
 		}
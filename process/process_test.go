@@ -0,0 +1,381 @@
+package process
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/infastin/l10n-go/ast"
+	"github.com/infastin/l10n-go/parse"
+	"github.com/infastin/l10n-go/scope"
+	"gopkg.in/yaml.v3"
+)
+
+func TestCheckRanges(t *testing.T) {
+	other := ast.FormatParts{ast.Text("other")}
+
+	// Ranges are given sorted by their lower bounds, as the parser sorts them
+	rangeCase := func(key string, min, max *int) ast.RangeCase {
+		c := ast.RangeCase{Key: key}
+		if min != nil {
+			c.Min = ast.BoundOpt{Value: *min, Valid: true}
+		}
+		if max != nil {
+			c.Max = ast.BoundOpt{Value: *max, Valid: true}
+		}
+		return c
+	}
+
+	n := func(value int) *int {
+		return &value
+	}
+
+	tests := []struct {
+		name  string
+		cases []ast.RangeCase
+		other ast.FormatParts
+		err   string
+	}{
+		{
+			name:  "single value with other",
+			cases: []ast.RangeCase{rangeCase("1", n(1), n(1))},
+			other: other,
+		},
+		{
+			name: "gaps with other",
+			cases: []ast.RangeCase{
+				rangeCase("2..4", n(2), n(4)),
+				rangeCase("12..99", n(12), n(99)),
+				rangeCase(">=100", n(100), nil),
+			},
+			other: other,
+		},
+		{
+			name: "all values covered",
+			cases: []ast.RangeCase{
+				rangeCase("<0", nil, n(-1)),
+				rangeCase("0..9", n(0), n(9)),
+				rangeCase(">9", n(10), nil),
+			},
+		},
+		{
+			name: "adjacent ranges with other below",
+			cases: []ast.RangeCase{
+				rangeCase("0..9", n(0), n(9)),
+				rangeCase(">=10", n(10), nil),
+			},
+			other: other,
+		},
+		{
+			name: "adjacent ranges with other above",
+			cases: []ast.RangeCase{
+				rangeCase("<=0", nil, n(0)),
+				rangeCase("1..9", n(1), n(9)),
+			},
+			other: other,
+		},
+		{
+			name:  "empty range",
+			cases: []ast.RangeCase{rangeCase("4..2", n(4), n(2))},
+			other: other,
+			err:   `could not process 4..2: empty range "4..2"`,
+		},
+		{
+			name: "overlapping ranges",
+			cases: []ast.RangeCase{
+				rangeCase("2..4", n(2), n(4)),
+				rangeCase("4..8", n(4), n(8)),
+			},
+			other: other,
+			err:   `could not process 4..8: ranges "2..4" and "4..8" overlap`,
+		},
+		{
+			name: "range inside of another range",
+			cases: []ast.RangeCase{
+				rangeCase("1..10", n(1), n(10)),
+				rangeCase("5", n(5), n(5)),
+			},
+			other: other,
+			err:   `could not process 5: ranges "1..10" and "5" overlap`,
+		},
+		{
+			name: "unbounded ranges below",
+			cases: []ast.RangeCase{
+				rangeCase("<5", nil, n(4)),
+				rangeCase("<=10", nil, n(10)),
+			},
+			other: other,
+			err:   `could not process <=10: ranges "<5" and "<=10" overlap`,
+		},
+		{
+			name: "unbounded range above",
+			cases: []ast.RangeCase{
+				rangeCase(">=10", n(10), nil),
+				rangeCase("20..30", n(20), n(30)),
+			},
+			other: other,
+			err:   `could not process 20..30: ranges ">=10" and "20..30" overlap`,
+		},
+		{
+			name: "unreachable other",
+			cases: []ast.RangeCase{
+				rangeCase("<0", nil, n(-1)),
+				rangeCase(">=0", n(0), nil),
+			},
+			other: other,
+			err:   "could not process other: other is unreachable, ranges cover all values",
+		},
+		{
+			name: "other not specified",
+			cases: []ast.RangeCase{
+				rangeCase("<0", nil, n(-1)),
+				rangeCase(">0", n(1), nil),
+			},
+			err: "could not process other: field not specified",
+		},
+		{
+			name:  "no ranges without other",
+			cases: nil,
+			err:   "could not process other: field not specified",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRanges(&ast.Range{
+				Arg:   "count",
+				Cases: tt.cases,
+				Other: tt.other,
+			})
+
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q", tt.err)
+			}
+			if err.Error() != tt.err {
+				t.Fatalf("expected error %q, got %q", tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestSortReferences(t *testing.T) {
+	type item struct {
+		name string
+		refs []string
+	}
+
+	tests := []struct {
+		name   string
+		items  []item
+		sorted []string
+		err    string
+	}{
+		{
+			name:   "no references",
+			items:  []item{{name: "a"}, {name: "b"}, {name: "c"}},
+			sorted: []string{"a", "b", "c"},
+		},
+		{
+			name: "chain",
+			items: []item{
+				{name: "a", refs: []string{"b"}},
+				{name: "b", refs: []string{"c"}},
+				{name: "c"},
+			},
+			sorted: []string{"c", "b", "a"},
+		},
+		{
+			name: "shared reference",
+			items: []item{
+				{name: "a", refs: []string{"b", "c"}},
+				{name: "b", refs: []string{"d"}},
+				{name: "c", refs: []string{"d"}},
+				{name: "d"},
+			},
+			sorted: []string{"d", "b", "c", "a"},
+		},
+		{
+			name: "unknown references",
+			items: []item{
+				{name: "a", refs: []string{"x"}},
+				{name: "b", refs: []string{"a", "y"}},
+			},
+			sorted: []string{"a", "b"},
+		},
+		{
+			name:  "self reference",
+			items: []item{{name: "a", refs: []string{"a"}}},
+			err:   "cyclic references: a -> a",
+		},
+		{
+			name: "two items",
+			items: []item{
+				{name: "a", refs: []string{"b"}},
+				{name: "b", refs: []string{"a"}},
+			},
+			err: "cyclic references: a -> b -> a",
+		},
+		{
+			name: "cycle reached through another item",
+			items: []item{
+				{name: "a", refs: []string{"b"}},
+				{name: "b", refs: []string{"c"}},
+				{name: "c", refs: []string{"d"}},
+				{name: "d", refs: []string{"b"}},
+			},
+			err: "cyclic references: b -> c -> d -> b",
+		},
+		{
+			name: "cycle after sorted items",
+			items: []item{
+				{name: "a"},
+				{name: "b", refs: []string{"a", "c"}},
+				{name: "c", refs: []string{"b"}},
+			},
+			err: "cyclic references: b -> c -> b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted, err := sortReferences(tt.items,
+				func(it *item) string {
+					return it.name
+				},
+				func(it *item) []string {
+					return it.refs
+				},
+			)
+
+			if tt.err != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got %+v", tt.err, sorted)
+				}
+				if err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %q", tt.err, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			names := make([]string, 0, len(sorted))
+			for _, it := range sorted {
+				names = append(names, it.name)
+			}
+			if !slices.Equal(names, tt.sorted) {
+				t.Fatalf("expected order %v, got %v", tt.sorted, names)
+			}
+		})
+	}
+}
+
+func TestProcessMessagesVariables(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		// Arguments of the variables including
+		// the arguments of the variables they reference
+		variables map[string][]string
+		// Variables in the order of generation
+		order []string
+		err   string
+	}{
+		{
+			name: "nested variables",
+			in: `
+Left:
+  variables:
+    duration: "&{hours} and &{minutes}"
+    hours: "${h} hours"
+    minutes: "${m} minutes"
+  string: "&{duration} left."
+`,
+			order: []string{"hours", "minutes", "duration"},
+			variables: map[string][]string{
+				"hours":    {"h"},
+				"minutes":  {"m"},
+				"duration": {"h", "m"},
+			},
+		},
+		{
+			name: "unknown variable",
+			in: `
+Left:
+  variables:
+    duration: "&{hours}"
+  string: "&{duration} left."
+`,
+			err: "could not process Left.duration.string.hours: variable not specified",
+		},
+		{
+			name: "self reference",
+			in: `
+Left:
+  variables:
+    duration: "&{duration}"
+  string: "&{duration} left."
+`,
+			err: "could not process Left.variables: cyclic references: duration -> duration",
+		},
+		{
+			name: "cycle",
+			in: `
+Left:
+  variables:
+    duration: "&{hours}"
+    hours: "&{minutes}"
+    minutes: "&{duration}"
+  string: "&{duration} left."
+`,
+			err: "could not process Left.variables: cyclic references: duration -> hours -> minutes -> duration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, terms, err := parse.UnmarshalMessages([]byte(tt.in), yaml.Unmarshal)
+			if err != nil {
+				t.Fatalf("could not unmarshal messages: %v", err)
+			}
+
+			mss, _, err := ProcessMessages(msgs, terms, scope.File{Path: "loc.en.yaml"})
+			if tt.err != "" {
+				if err == nil {
+					t.Fatalf("expected error %q", tt.err)
+				}
+				if err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %q", tt.err, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var order []string
+			for _, variable := range mss[0].Variables {
+				order = append(order, variable.Name)
+
+				args := slices.Clone(variable.ArgumentNames)
+				slices.Sort(args)
+				if !slices.Equal(args, tt.variables[variable.Name]) {
+					t.Fatalf("expected arguments %v of %s, got %v",
+						tt.variables[variable.Name], variable.Name, args)
+				}
+			}
+			if !slices.Equal(order, tt.order) {
+				t.Fatalf("expected order %v, got %v", tt.order, order)
+			}
+		})
+	}
+}
@@ -24,6 +24,9 @@ func ArgumentIndex(arguments []Argument, name string) (idx int) {
 type VariableScope struct {
 	ast.Variable
 	ArgumentNames []string
+	VariableNames []string
+	TermNames     []string
+	MessageNames  []string
 }
 
 func VariableScopeIndex(variables []VariableScope, name string) (idx int) {
@@ -36,25 +39,138 @@ func VariableScopeIndex(variables []VariableScope, name string) (idx int) {
 }
 
 type MessageScope struct {
-	Name      string
-	Variables []VariableScope
-	Plural    ast.Plural
-	String    ast.FormatParts
-	Arguments []Argument
+	Name         string
+	Namespace    string
+	Variables    []VariableScope
+	Plural       ast.Plural
+	Ordinal      ast.Plural
+	Select       ast.Select
+	When         ast.When
+	Range        ast.Range
+	String       ast.FormatParts
+	Arguments    []Argument
+	TermNames    []string
+	MessageNames []string
+	// File the message is defined in,
+	// which is used to find the terms it references
+	File File
+	// Localization the message is delegated to
+	// if it is not specified in the localization
+	Delegate *Localization
+	// Localization the message is inherited from
+	// if it references messages overridden in the localization,
+	// so it can't be delegated and uses the terms of that localization
+	Inherited *Localization
+}
+
+// Returns the message name qualified with its namespace.
+func (m *MessageScope) FullName() string {
+	return ast.JoinNamespace(m.Namespace, m.Name)
+}
+
+// Finds the message by its name qualified with its namespace.
+func MessageScopeIndex(msgs []MessageScope, name string) (idx int) {
+	for i := 0; i < len(msgs); i++ {
+		if msgs[i].FullName() == name {
+			return i
+		}
+	}
+	return -1
 }
 
 func (m *MessageScope) IsSimple() bool {
 	if !m.Plural.IsZero() {
 		return m.Plural.IsSimple()
 	}
+	if !m.Ordinal.IsZero() {
+		return m.Ordinal.IsSimple()
+	}
+	if !m.Select.IsZero() {
+		return m.Select.IsSimple()
+	}
+	if !m.When.IsZero() {
+		return m.When.IsSimple()
+	}
+	if !m.Range.IsZero() {
+		return m.Range.IsSimple()
+	}
 	return m.String.IsSimple()
 }
 
+// TermScope is a term processed the same way as a message without variables.
+// Each of the term forms is processed the same way too,
+// and arguments of the term include arguments of all its forms.
+type TermScope struct {
+	MessageScope
+	Forms  []MessageScope
+	Gender string
+}
+
+// Checks whether the term has a value, which can be omitted if the term has forms.
+func (t *TermScope) HasValue() bool {
+	return !t.Plural.IsZero() ||
+		!t.Ordinal.IsZero() ||
+		!t.Select.IsZero() ||
+		!t.When.IsZero() ||
+		!t.Range.IsZero() ||
+		!t.String.IsZero()
+}
+
+func (t *TermScope) FormIndex(name string) (idx int) {
+	for i := 0; i < len(t.Forms); i++ {
+		if t.Forms[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func TermScopeIndex(terms []TermScope, name string) (idx int) {
+	for i := 0; i < len(terms); i++ {
+		if terms[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Finds the term defined in the file, since terms are visible only within their files.
+func FileTermScopeIndex(terms []TermScope, file File, name string) (idx int) {
+	for i := 0; i < len(terms); i++ {
+		if terms[i].File == file && terms[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// PluralFunc is a function that selects plural form
+// for integral or floating point numbers.
+type PluralFunc struct {
+	Type  ast.PluralType
+	Float bool
+}
+
+// Localization file the messages and terms are defined in.
+type File struct {
+	Path string
+	// Index of the file among the files of the localization,
+	// which identifies the file in the names of the generated functions
+	Index int
+}
+
 type Localization struct {
-	Name    string
-	Lang    language.Tag
-	Scopes  []MessageScope
-	Imports []ast.GoImport
+	Name string
+	Lang language.Tag
+	// Closest localization among the parents of the language,
+	// e.g. en for en-GB
+	Parent *Localization
+	Scopes []MessageScope
+	// Names of the messages falling back to the base localization
+	Fallbacks   []string
+	Terms       []TermScope
+	Imports     []ast.GoImport
+	PluralFuncs []PluralFunc
 }
 
 func (loc *Localization) AddImport(imp ast.GoImport) {
@@ -63,6 +179,24 @@ func (loc *Localization) AddImport(imp ast.GoImport) {
 	}
 }
 
+func (loc *Localization) AddPluralFunc(fn PluralFunc) {
+	if !slices.Contains(loc.PluralFuncs, fn) {
+		loc.PluralFuncs = append(loc.PluralFuncs, fn)
+	}
+}
+
+// Finds the closest localization among the parents of the language.
+func ParentLocalizationIndex(locs []Localization, lang language.Tag) (idx int) {
+	for parent := lang.Parent(); parent != language.Und; parent = parent.Parent() {
+		idx = LocalizationIndex(locs, parent)
+		if idx != -1 {
+			return idx
+		}
+	}
+
+	return -1
+}
+
 func LocalizationIndex(locs []Localization, lang language.Tag) (idx int) {
 	for i := 0; i < len(locs); i++ {
 		if locs[i].Lang.String() == lang.String() {